@@ -0,0 +1,71 @@
+package kuvera
+
+import (
+	"context"
+	"fmt"
+)
+
+// LinkedAccount represents a family member's account linked to the user's login.
+type LinkedAccount struct {
+	// ID is the account identifier, as accepted by GetPortfolioForAccount
+	ID string `json:"id"`
+	// Name is the account holder's name
+	Name string `json:"name"`
+	// Relationship is the holder's relationship to the user (e.g., "self", "spouse")
+	Relationship string `json:"relationship"`
+	// Primary indicates if this is the user's own primary account
+	Primary bool `json:"primary"`
+}
+
+// LinkedAccountsResponse represents the response from the linked accounts API endpoint.
+type LinkedAccountsResponse struct {
+	// Status indicates if the request was successful
+	Status string `json:"status"`
+	// Data contains the linked accounts; empty for users without linked accounts
+	Data []LinkedAccount `json:"data"`
+}
+
+// GetLinkedAccounts retrieves the family accounts linked to the user's login.
+//
+// The account IDs can be passed to GetPortfolioForAccount. Users without
+// linked accounts get an empty Data slice rather than an error. The user must
+// be authenticated (logged in) before calling this method.
+//
+// Returns:
+//   - LinkedAccountsResponse: Contains each account's ID, name, and relationship
+//   - error: Authentication errors, network errors, or API errors
+//
+// Example:
+//
+//	accounts, err := client.GetLinkedAccounts(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, account := range accounts.Data {
+//		portfolio, err := client.GetPortfolioForAccount(ctx, account.ID)
+//		if err != nil {
+//			log.Fatal(err)
+//		}
+//		fmt.Printf("%s (%s): ₹%.2f\n", account.Name, account.Relationship, portfolio.Data.CurrentValue)
+//	}
+func (c *Client) GetLinkedAccounts(ctx context.Context) (*LinkedAccountsResponse, error) {
+	if c.token() == "" {
+		return nil, ErrNotAuthenticated
+	}
+
+	resp, err := c.makeRequest(ctx, "GET", "/api/v3/family/accounts.json", nil)
+	if err != nil {
+		return nil, fmt.Errorf("linked accounts request failed: %w", err)
+	}
+
+	var accountsResp LinkedAccountsResponse
+	if err := c.handleResponse(resp, &accountsResp, "linked accounts"); err != nil {
+		return &accountsResp, err
+	}
+
+	if accountsResp.Data == nil {
+		accountsResp.Data = []LinkedAccount{}
+	}
+
+	return &accountsResp, nil
+}
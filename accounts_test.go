@@ -0,0 +1,47 @@
+package kuvera
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestGetLinkedAccounts(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v3/family/accounts.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"status":"success","data":[
+			{"id":"SELF1","name":"Asha Rao","relationship":"self","primary":true},
+			{"id":"FAM2","name":"Ravi Rao","relationship":"spouse","primary":false}
+		]}`))
+	})
+
+	accounts, err := client.GetLinkedAccounts(context.Background())
+	if err != nil {
+		t.Fatalf("GetLinkedAccounts() error = %v", err)
+	}
+	if len(accounts.Data) != 2 {
+		t.Fatalf("got %d accounts, want 2", len(accounts.Data))
+	}
+	if primary := accounts.Data[0]; primary.ID != "SELF1" || !primary.Primary || primary.Relationship != "self" {
+		t.Errorf("unexpected primary account: %+v", primary)
+	}
+	if spouse := accounts.Data[1]; spouse.ID != "FAM2" || spouse.Primary || spouse.Name != "Ravi Rao" || spouse.Relationship != "spouse" {
+		t.Errorf("unexpected linked account: %+v", spouse)
+	}
+}
+
+func TestGetLinkedAccountsSolo(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":"success","data":[]}`))
+	})
+
+	accounts, err := client.GetLinkedAccounts(context.Background())
+	if err != nil {
+		t.Fatalf("GetLinkedAccounts() error = %v", err)
+	}
+	if accounts.Data == nil || len(accounts.Data) != 0 {
+		t.Errorf("Data = %#v, want an empty slice", accounts.Data)
+	}
+}
@@ -0,0 +1,77 @@
+package kuvera
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// USEquitiesData represents US equities investment data.
+type USEquitiesData struct {
+	// CurrentValue is the current value of US equities in INR
+	CurrentValue float64 `json:"current_value"`
+	// TotalInvested is the total amount invested in INR
+	TotalInvested float64 `json:"total_invested"`
+	// OneDayChange is the one-day change in value
+	OneDayChange float64 `json:"one_day_change"`
+}
+
+// EPFData represents Employees' Provident Fund data.
+//
+// The portfolio summary only carries CurrentValue and TotalInvested; the
+// remaining fields are filled in by GetEPFDetails.
+type EPFData struct {
+	// UAN is the Universal Account Number of the linked EPF account
+	UAN string `json:"uan"`
+	// CurrentValue is the current EPF balance
+	CurrentValue float64 `json:"current_value"`
+	// TotalInvested is the total amount contributed
+	TotalInvested float64 `json:"total_invested"`
+	// EmployeeContribution is the total contributed by the employee
+	EmployeeContribution float64 `json:"employee_contribution"`
+	// EmployerContribution is the total contributed by the employer
+	EmployerContribution float64 `json:"employer_contribution"`
+	// LastUpdated is when the balance was last synced from EPFO (YYYY-MM-DD)
+	LastUpdated string `json:"last_updated"`
+}
+
+// SaveSmartsData represents Kuvera Save Smart (liquid fund savings) data.
+type SaveSmartsData struct {
+	// CurrentValue is the current value of the savings
+	CurrentValue float64 `json:"current_value"`
+	// TotalInvested is the total amount invested
+	TotalInvested float64 `json:"total_invested"`
+	// OneDayChange is the one-day change in value
+	OneDayChange float64 `json:"one_day_change"`
+}
+
+// UnmarshalJSON decodes US equities data, accepting the empty values the API
+// sends for users without US equities.
+func (d *USEquitiesData) UnmarshalJSON(data []byte) error {
+	type plain USEquitiesData
+	return decodeOptionalObject(data, (*plain)(d))
+}
+
+// UnmarshalJSON decodes EPF data, accepting the empty values the API sends for
+// users without EPF.
+func (d *EPFData) UnmarshalJSON(data []byte) error {
+	type plain EPFData
+	return decodeOptionalObject(data, (*plain)(d))
+}
+
+// UnmarshalJSON decodes Save Smart data, accepting the empty values the API
+// sends for users without Save Smart.
+func (d *SaveSmartsData) UnmarshalJSON(data []byte) error {
+	type plain SaveSmartsData
+	return decodeOptionalObject(data, (*plain)(d))
+}
+
+// decodeOptionalObject decodes an asset class object into v. Absent asset
+// classes come back as an empty object, but null and an empty array are
+// tolerated as well and leave v unchanged.
+func decodeOptionalObject(data []byte, v interface{}) error {
+	trimmed := bytes.TrimSpace(data)
+	if bytes.Equal(trimmed, []byte("null")) || bytes.Equal(trimmed, []byte("[]")) {
+		return nil
+	}
+	return json.Unmarshal(data, v)
+}
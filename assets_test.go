@@ -0,0 +1,43 @@
+package kuvera
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestOptionalAssetClassesEmpty(t *testing.T) {
+	for _, value := range []string{`{}`, `null`, `[]`} {
+		body := `{"us_equities":` + value + `,"epf":` + value + `,"save_smarts":` + value + `}`
+
+		var portfolio PortfolioData
+		if err := json.Unmarshal([]byte(body), &portfolio); err != nil {
+			t.Fatalf("Unmarshal(%s) error = %v", body, err)
+		}
+		if portfolio.USEquities != (USEquitiesData{}) || portfolio.EPF != (EPFData{}) || portfolio.SaveSmarts != (SaveSmartsData{}) {
+			t.Errorf("Unmarshal(%s) = %+v, want zero asset classes", body, portfolio)
+		}
+	}
+}
+
+func TestOptionalAssetClassesPopulated(t *testing.T) {
+	body := `{
+		"us_equities": {"current_value": 85000.5, "total_invested": 70000, "one_day_change": -1200.25},
+		"epf": {"current_value": 450000, "total_invested": 380000},
+		"save_smarts": {"current_value": 15000, "total_invested": 14800, "one_day_change": 2.5}
+	}`
+
+	var portfolio PortfolioData
+	if err := json.Unmarshal([]byte(body), &portfolio); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if want := (USEquitiesData{CurrentValue: 85000.5, TotalInvested: 70000, OneDayChange: -1200.25}); portfolio.USEquities != want {
+		t.Errorf("USEquities = %+v, want %+v", portfolio.USEquities, want)
+	}
+	if want := (EPFData{CurrentValue: 450000, TotalInvested: 380000}); portfolio.EPF != want {
+		t.Errorf("EPF = %+v, want %+v", portfolio.EPF, want)
+	}
+	if want := (SaveSmartsData{CurrentValue: 15000, TotalInvested: 14800, OneDayChange: 2.5}); portfolio.SaveSmarts != want {
+		t.Errorf("SaveSmarts = %+v, want %+v", portfolio.SaveSmarts, want)
+	}
+}
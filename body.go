@@ -0,0 +1,65 @@
+package kuvera
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+)
+
+// rawBody is a makeRequest payload that is sent as is, rather than encoded as
+// JSON, such as a multipart form.
+type rawBody struct {
+	contentType string
+	data        []byte
+}
+
+// readerBody is a makeRequest payload streamed from r. It is read into memory
+// before the first attempt, so that retries can send it again.
+type readerBody struct {
+	contentType string
+	r           io.Reader
+}
+
+// bufferPayload reads a readerBody payload into a rawBody. Other payloads are
+// returned unchanged.
+func bufferPayload(payload interface{}) (interface{}, error) {
+	p, ok := payload.(readerBody)
+	if !ok {
+		return payload, nil
+	}
+	data, err := io.ReadAll(p.r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+	return rawBody{contentType: p.contentType, data: data}, nil
+}
+
+// encodePayload returns the request body for a makeRequest payload and its
+// content type, according to the payload's type:
+//
+//   - nil sends no body
+//   - url.Values is sent as application/x-www-form-urlencoded
+//   - rawBody and readerBody are sent as is with their content type, e.g. a
+//     multipart form
+//   - anything else, such as a request struct, is encoded as JSON
+func encodePayload(payload interface{}) (io.Reader, string, error) {
+	switch p := payload.(type) {
+	case nil:
+		return nil, "", nil
+	case url.Values:
+		return strings.NewReader(p.Encode()), "application/x-www-form-urlencoded", nil
+	case rawBody:
+		return bytes.NewReader(p.data), p.contentType, nil
+	case readerBody:
+		return p.r, p.contentType, nil
+	default:
+		jsonData, err := json.Marshal(payload)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to marshal request body: %w", err)
+		}
+		return bytes.NewReader(jsonData), "application/json;charset=utf-8", nil
+	}
+}
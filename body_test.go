@@ -0,0 +1,64 @@
+package kuvera
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestMakeRequestBodies(t *testing.T) {
+	tests := []struct {
+		name        string
+		payload     interface{}
+		contentType string
+		body        string
+	}{
+		{"none", nil, "", ""},
+		{"json", watchlistRequest{FundCode: "AFUND"}, "application/json;charset=utf-8", `{"fund_code":"AFUND"}`},
+		{"form", url.Values{"fund_code": {"A FUND"}}, "application/x-www-form-urlencoded", "fund_code=A+FUND"},
+		{"raw", rawBody{contentType: "text/csv", data: []byte("a,b\n")}, "text/csv", "a,b\n"},
+		{"reader", readerBody{contentType: "application/pdf", r: strings.NewReader("%PDF")}, "application/pdf", "%PDF"},
+	}
+
+	for _, tt := range tests {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if got := r.Header.Get("Content-Type"); got != tt.contentType {
+				t.Errorf("%s: Content-Type = %q, want %q", tt.name, got, tt.contentType)
+			}
+			if got, _ := io.ReadAll(r.Body); string(got) != tt.body {
+				t.Errorf("%s: body = %q, want %q", tt.name, got, tt.body)
+			}
+		})
+
+		resp, err := client.makeRequest(context.Background(), "POST", "/upload", tt.payload)
+		if err != nil {
+			t.Fatalf("%s: makeRequest() error = %v", tt.name, err)
+		}
+		resp.Body.Close()
+	}
+}
+
+func TestMakeRequestReaderBodyRetried(t *testing.T) {
+	var bodies []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		got, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(got))
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}, WithRetry(1, 0))
+
+	ctx := WithIdempotencyKey(context.Background(), "upload-1")
+	resp, err := client.makeRequest(ctx, "POST", "/upload", readerBody{contentType: "text/plain", r: strings.NewReader("payload")})
+	if err != nil {
+		t.Fatalf("makeRequest() error = %v", err)
+	}
+	resp.Body.Close()
+
+	if len(bodies) != 2 || bodies[0] != "payload" || bodies[1] != "payload" {
+		t.Errorf("bodies sent = %q, want the payload on both attempts", bodies)
+	}
+}
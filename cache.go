@@ -0,0 +1,111 @@
+package kuvera
+
+import (
+	"sync"
+	"time"
+)
+
+// WithCache caches successful GET responses in memory for ttl.
+//
+// Cached responses are keyed by request URL and shared across all methods on the
+// client. The cache is cleared on every Login so data from a previous session is
+// never served, and after every successful write request (any method other
+// than GET or HEAD), so that, for example, GetWatchlist reflects a preceding
+// AddToWatchlist. A non-positive ttl disables caching.
+//
+// Responses that carry an ETag, such as the gold price, are kept after they
+// expire and revalidated with If-None-Match: a 304 Not Modified reply serves
+// the cached response again for another ttl without downloading it.
+func WithCache(ttl time.Duration) ClientOption {
+	return func(c *clientConfig) {
+		c.cacheTTL = ttl
+	}
+}
+
+// responseCache is an in-memory TTL cache of response bodies safe for concurrent use.
+type responseCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	clock   clock
+	entries map[string]cacheEntry
+}
+
+// cacheEntry is a cached response body, its ETag if any, and its expiry time.
+type cacheEntry struct {
+	body    []byte
+	etag    string
+	expires time.Time
+}
+
+// newResponseCache returns an empty cache whose entries live for ttl.
+func newResponseCache(ttl time.Duration, clk clock) *responseCache {
+	return &responseCache{
+		ttl:     ttl,
+		clock:   clk,
+		entries: make(map[string]cacheEntry),
+	}
+}
+
+// get returns the cached body for key if present and not expired.
+func (c *responseCache) get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !c.clock.Now().Before(entry.expires) {
+		// Expired entries with an ETag are kept for revalidation
+		if entry.etag == "" {
+			delete(c.entries, key)
+		}
+		return nil, false
+	}
+	return entry.body, true
+}
+
+// etag returns the ETag of the entry for key, if it has one.
+func (c *responseCache) etag(key string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	return entry.etag, ok && entry.etag != ""
+}
+
+// set stores body and its ETag, which may be empty, under key until the cache
+// TTL elapses.
+func (c *responseCache) set(key string, body []byte, etag string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[key] = cacheEntry{
+		body:    body,
+		etag:    etag,
+		expires: c.clock.Now().Add(c.ttl),
+	}
+}
+
+// revalidate renews the entry for key after the server reported it unchanged
+// and returns its body.
+func (c *responseCache) revalidate(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry.expires = c.clock.Now().Add(c.ttl)
+	c.entries[key] = entry
+	return entry.body, true
+}
+
+// clear removes all entries.
+func (c *responseCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	clear(c.entries)
+}
@@ -0,0 +1,98 @@
+package kuvera
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWithCacheExpiry(t *testing.T) {
+	var hits atomic.Int32
+	clk := newFakeClock()
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.Write([]byte(`{"status":"success","data":{"current_value":100}}`))
+	}, WithCache(time.Minute), withClock(clk))
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		portfolio, err := client.GetPortfolio(ctx)
+		if err != nil {
+			t.Fatalf("GetPortfolio() error = %v", err)
+		}
+		if portfolio.Data.CurrentValue != 100 {
+			t.Errorf("CurrentValue = %v, want 100", portfolio.Data.CurrentValue)
+		}
+	}
+	if got := hits.Load(); got != 1 {
+		t.Errorf("server hits before expiry = %d, want 1", got)
+	}
+
+	clk.Advance(time.Minute)
+	if _, err := client.GetPortfolio(ctx); err != nil {
+		t.Fatalf("GetPortfolio() error = %v", err)
+	}
+	if got := hits.Load(); got != 2 {
+		t.Errorf("server hits after expiry = %d, want 2", got)
+	}
+}
+
+func TestWithCacheSkipsErrors(t *testing.T) {
+	var hits atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"code":503,"message":"unavailable"}`))
+	}, WithCache(time.Minute), withClock(newFakeClock()))
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetPortfolio(context.Background()); err == nil {
+			t.Fatal("GetPortfolio() error = nil, want error")
+		}
+	}
+	if got := hits.Load(); got != 2 {
+		t.Errorf("server hits = %d, want 2", got)
+	}
+}
+
+func TestWithCacheRevalidatesETag(t *testing.T) {
+	var hits atomic.Int32
+	clk := newFakeClock()
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		if r.Header.Get("If-None-Match") == `"gold-v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"gold-v1"`)
+		w.Write([]byte(`{"current_gold_price":{"buy":6250.5,"sell":6100.25}}`))
+	}, WithCache(time.Minute), withClock(clk))
+
+	ctx := context.Background()
+	first, err := client.GetGoldPrice(ctx)
+	if err != nil {
+		t.Fatalf("GetGoldPrice() error = %v", err)
+	}
+
+	clk.Advance(time.Minute)
+	second, err := client.GetGoldPrice(ctx)
+	if err != nil {
+		t.Fatalf("GetGoldPrice() after 304 error = %v", err)
+	}
+	if second.CurrentGoldPrice != first.CurrentGoldPrice || second.CurrentGoldPrice.Buy != 6250.5 {
+		t.Errorf("CurrentGoldPrice after 304 = %+v, want %+v", second.CurrentGoldPrice, first.CurrentGoldPrice)
+	}
+	if got := hits.Load(); got != 2 {
+		t.Errorf("server hits = %d, want 2", got)
+	}
+
+	// The revalidated response is fresh for another TTL
+	if _, err := client.GetGoldPrice(ctx); err != nil {
+		t.Fatalf("GetGoldPrice() error = %v", err)
+	}
+	if got := hits.Load(); got != 2 {
+		t.Errorf("server hits after revalidation = %d, want 2", got)
+	}
+}
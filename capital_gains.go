@@ -0,0 +1,121 @@
+package kuvera
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+)
+
+// ErrInvalidFinancialYear is returned when a financial year is not of the form "2023-2024".
+var ErrInvalidFinancialYear = errors.New("invalid financial year: expected format YYYY-YYYY with consecutive years")
+
+// financialYearPattern matches financial years such as "2023-2024".
+var financialYearPattern = regexp.MustCompile(`^(\d{4})-(\d{4})$`)
+
+// GainType classifies a realized capital gain for tax purposes.
+type GainType string
+
+// Capital gain classifications.
+const (
+	// GainShortTerm is a short-term capital gain (STCG)
+	GainShortTerm GainType = "STCG"
+	// GainLongTerm is a long-term capital gain (LTCG)
+	GainLongTerm GainType = "LTCG"
+)
+
+// CapitalGainEntry represents a single realized gain from a redemption.
+type CapitalGainEntry struct {
+	// FundCode is the code of the redeemed fund
+	FundCode string `json:"fund_code"`
+	// FundName is the name of the redeemed fund
+	FundName string `json:"fund_name"`
+	// Units is the number of units redeemed
+	Units float64 `json:"units"`
+	// PurchaseDate is the date the redeemed units were purchased
+	PurchaseDate string `json:"purchase_date"`
+	// RedemptionDate is the date the units were redeemed
+	RedemptionDate string `json:"redemption_date"`
+	// PurchaseValue is the cost of the redeemed units
+	PurchaseValue float64 `json:"purchase_value"`
+	// SaleValue is the amount received on redemption
+	SaleValue float64 `json:"sale_value"`
+	// Gain is the realized gain (negative for a loss)
+	Gain float64 `json:"gain"`
+	// GainType is the tax classification of the gain
+	GainType GainType `json:"gain_type"`
+}
+
+// CapitalGainsData represents the capital gains statement for a financial year.
+type CapitalGainsData struct {
+	// FinancialYear is the financial year of the statement (e.g., "2023-2024")
+	FinancialYear string `json:"financial_year"`
+	// Entries contains the realized gains per fund and purchase lot
+	Entries []CapitalGainEntry `json:"entries"`
+}
+
+// CapitalGainsResponse represents the response from the capital gains API endpoint.
+type CapitalGainsResponse struct {
+	// Status indicates if the request was successful
+	Status string `json:"status"`
+	// Data contains the capital gains statement
+	Data CapitalGainsData `json:"data"`
+}
+
+// validateFinancialYear checks that financialYear is of the form "2023-2024".
+func validateFinancialYear(financialYear string) error {
+	matches := financialYearPattern.FindStringSubmatch(financialYear)
+	if matches == nil {
+		return ErrInvalidFinancialYear
+	}
+	start, _ := strconv.Atoi(matches[1])
+	end, _ := strconv.Atoi(matches[2])
+	if end != start+1 {
+		return ErrInvalidFinancialYear
+	}
+	return nil
+}
+
+// GetCapitalGains retrieves the realized short-term and long-term capital gains
+// for the given financial year.
+//
+// The financial year must be given as two consecutive years, e.g. "2023-2024".
+// The user must be authenticated (logged in) before calling this method.
+//
+// Returns:
+//   - CapitalGainsResponse: Contains the realized gains per fund
+//   - error: Validation errors, authentication errors, network errors, or API errors
+//
+// Example:
+//
+//	gains, err := client.GetCapitalGains(ctx, "2023-2024")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, entry := range gains.Data.Entries {
+//		fmt.Printf("%s %s: ₹%.2f\n", entry.FundCode, entry.GainType, entry.Gain)
+//	}
+func (c *Client) GetCapitalGains(ctx context.Context, financialYear string) (*CapitalGainsResponse, error) {
+	if err := validateFinancialYear(financialYear); err != nil {
+		return nil, err
+	}
+	if c.token() == "" {
+		return nil, ErrNotAuthenticated
+	}
+
+	query := url.Values{"financial_year": {financialYear}}
+	endpoint := "/api/v3/portfolio/capital_gains.json?" + query.Encode()
+	resp, err := c.makeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("capital gains request failed: %w", err)
+	}
+
+	var gainsResp CapitalGainsResponse
+	if err := c.handleResponse(resp, &gainsResp, "capital gains"); err != nil {
+		return &gainsResp, err
+	}
+
+	return &gainsResp, nil
+}
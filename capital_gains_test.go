@@ -0,0 +1,76 @@
+package kuvera
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestValidateFinancialYear(t *testing.T) {
+	tests := []struct {
+		financialYear string
+		wantErr       bool
+	}{
+		{"2023-2024", false},
+		{"1999-2000", false},
+		{"", true},
+		{"2023", true},
+		{"2023-24", true},
+		{"2023/2024", true},
+		{"2023-2025", true},
+		{"2024-2023", true},
+		{" 2023-2024", true},
+	}
+
+	for _, tt := range tests {
+		err := validateFinancialYear(tt.financialYear)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("validateFinancialYear(%q) error = %v, wantErr %t", tt.financialYear, err, tt.wantErr)
+		}
+		if err != nil && !errors.Is(err, ErrInvalidFinancialYear) {
+			t.Errorf("validateFinancialYear(%q) error = %v, want %v", tt.financialYear, err, ErrInvalidFinancialYear)
+		}
+	}
+}
+
+func TestGetCapitalGainsInvalidFinancialYear(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request expected for an invalid financial year")
+	})
+
+	if _, err := client.GetCapitalGains(context.Background(), "FY24"); !errors.Is(err, ErrInvalidFinancialYear) {
+		t.Errorf("GetCapitalGains() error = %v, want %v", err, ErrInvalidFinancialYear)
+	}
+}
+
+func TestGetCapitalGains(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v3/portfolio/capital_gains.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("financial_year"); got != "2023-2024" {
+			t.Errorf("financial_year = %q, want %q", got, "2023-2024")
+		}
+		w.Write([]byte(`{"status":"success","data":{"financial_year":"2023-2024","entries":[
+			{"fund_code":"PPFAS-GR","fund_name":"Parag Parikh Flexi Cap","units":10.5,"purchase_date":"2023-06-01","redemption_date":"2024-01-15","purchase_value":6000,"sale_value":7000,"gain":1000,"gain_type":"STCG"},
+			{"fund_code":"UTINI-GR","fund_name":"UTI Nifty 50 Index","units":100,"purchase_date":"2020-04-01","redemption_date":"2024-03-10","purchase_value":8000,"sale_value":15000,"gain":7000,"gain_type":"LTCG"}
+		]}}`))
+	})
+
+	gains, err := client.GetCapitalGains(context.Background(), "2023-2024")
+	if err != nil {
+		t.Fatalf("GetCapitalGains() error = %v", err)
+	}
+	if len(gains.Data.Entries) != 2 {
+		t.Fatalf("got %d entries, want 2", len(gains.Data.Entries))
+	}
+
+	stcg, ltcg := gains.Data.Entries[0], gains.Data.Entries[1]
+	if stcg.GainType != GainShortTerm || stcg.FundCode != "PPFAS-GR" || stcg.SaleValue != 7000 {
+		t.Errorf("unexpected STCG entry: %+v", stcg)
+	}
+	if ltcg.GainType != GainLongTerm || ltcg.PurchaseDate != "2020-04-01" || ltcg.Gain != 7000 {
+		t.Errorf("unexpected LTCG entry: %+v", ltcg)
+	}
+}
@@ -0,0 +1,92 @@
+package kuvera
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"mime/multipart"
+)
+
+// ErrEmptyCASData is returned by ImportCAS when no statement is given.
+var ErrEmptyCASData = errors.New("CAS statement cannot be empty")
+
+// CASImportResponse represents the response from the CAS import API endpoint.
+// The import runs in the background; the response describes the queued job.
+type CASImportResponse struct {
+	// Status indicates if the upload was accepted
+	Status string `json:"status"`
+	// JobID identifies the import job
+	JobID string `json:"job_id"`
+	// State is the state of the import job (e.g., "queued")
+	State string `json:"state"`
+	// Message is a human-readable description of the job's state
+	Message string `json:"message,omitempty"`
+}
+
+// ImportCAS uploads a Consolidated Account Statement (CAS) from CAMS or
+// KFintech so that Kuvera imports the holdings it lists, including those
+// bought outside Kuvera.
+//
+// casData is the statement PDF and password the password it is protected
+// with, if any. The statement must not be empty. The import is processed
+// asynchronously; the response reports the queued job. The user must be
+// authenticated (logged in) before calling this method.
+//
+// Example:
+//
+//	statement, err := os.ReadFile("cas.pdf")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	job, err := client.ImportCAS(ctx, statement, os.Getenv("CAS_PASSWORD"))
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Import %s is %s\n", job.JobID, job.State)
+func (c *Client) ImportCAS(ctx context.Context, casData []byte, password string) (*CASImportResponse, error) {
+	if len(casData) == 0 {
+		return nil, ErrEmptyCASData
+	}
+	if c.token() == "" {
+		return nil, ErrNotAuthenticated
+	}
+
+	body, err := casImportBody(casData, password)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.makeRequest(ctx, "POST", "/api/v3/cas/import.json", body)
+	if err != nil {
+		return nil, fmt.Errorf("CAS import request failed: %w", err)
+	}
+
+	var importResp CASImportResponse
+	if err := c.handleResponse(resp, &importResp, "CAS import"); err != nil {
+		return &importResp, err
+	}
+
+	return &importResp, nil
+}
+
+// casImportBody encodes the statement and its password as a multipart form.
+func casImportBody(casData []byte, password string) (rawBody, error) {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+
+	part, err := w.CreateFormFile("file", "cas.pdf")
+	if err != nil {
+		return rawBody{}, fmt.Errorf("failed to encode CAS statement: %w", err)
+	}
+	if _, err := part.Write(casData); err != nil {
+		return rawBody{}, fmt.Errorf("failed to encode CAS statement: %w", err)
+	}
+	if err := w.WriteField("password", password); err != nil {
+		return rawBody{}, fmt.Errorf("failed to encode CAS statement: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return rawBody{}, fmt.Errorf("failed to encode CAS statement: %w", err)
+	}
+
+	return rawBody{contentType: w.FormDataContentType(), data: buf.Bytes()}, nil
+}
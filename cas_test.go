@@ -0,0 +1,59 @@
+package kuvera
+
+import (
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"testing"
+)
+
+func TestImportCAS(t *testing.T) {
+	statement := []byte("%PDF-1.4 consolidated account statement")
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/v3/cas/import.json" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType != "multipart/form-data" {
+			t.Errorf("Content-Type = %q, want multipart/form-data", r.Header.Get("Content-Type"))
+		}
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Errorf("ParseMultipartForm() error = %v", err)
+			return
+		}
+		if got := r.FormValue("password"); got != "ABCDE1234F" {
+			t.Errorf("password = %q, want %q", got, "ABCDE1234F")
+		}
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Errorf("FormFile() error = %v", err)
+			return
+		}
+		defer file.Close()
+		if got, _ := io.ReadAll(file); string(got) != string(statement) || header.Filename != "cas.pdf" {
+			t.Errorf("file %q = %q, want the statement", header.Filename, got)
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{"status":"success","job_id":"cas-42","state":"queued"}`))
+	})
+
+	job, err := client.ImportCAS(context.Background(), statement, "ABCDE1234F")
+	if err != nil {
+		t.Fatalf("ImportCAS() error = %v", err)
+	}
+	if job.JobID != "cas-42" || job.State != "queued" {
+		t.Errorf("ImportCAS() = %+v, want queued job cas-42", job)
+	}
+}
+
+func TestImportCASValidation(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request expected for an empty statement")
+	})
+
+	if _, err := client.ImportCAS(context.Background(), nil, "secret"); !errors.Is(err, ErrEmptyCASData) {
+		t.Errorf("ImportCAS() error = %v, want %v", err, ErrEmptyCASData)
+	}
+}
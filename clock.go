@@ -0,0 +1,26 @@
+package kuvera
+
+import "time"
+
+// clock abstracts time so that TTL expiry and backoff can be tested
+// deterministically without sleeping.
+type clock interface {
+	// Now returns the current time
+	Now() time.Time
+	// After waits for the duration to elapse and then sends the current time
+	After(d time.Duration) <-chan time.Time
+}
+
+// realClock is the clock backed by the time package, used by default.
+type realClock struct{}
+
+func (realClock) Now() time.Time { return time.Now() }
+
+func (realClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// withClock replaces the client's clock. It is intended for tests only.
+func withClock(clk clock) ClientOption {
+	return func(c *clientConfig) {
+		c.clock = clk
+	}
+}
@@ -0,0 +1,37 @@
+package kuvera
+
+import "maps"
+
+// Clone returns a new client with the same configuration, token and session
+// as c, with options applied on top. This allows, for example, a client with a
+// longer timeout for a heavy call without logging in again:
+//
+//	slow := client.Clone(kuvera.WithTimeout(2 * time.Minute))
+//	holdings, err := slow.GetHoldings(ctx)
+//
+// The clone has its own http.Client, so options such as WithTimeout do not
+// affect c. Its request counters and response cache start empty, while the
+// rate limiter and cookie jar, which belong to the account, are shared, as is
+// a recorder set with WithHARRecorder, which only c's Close writes out.
+func (c *Client) Clone(options ...ClientOption) KuveraClient {
+	config := c.config
+	httpClient := *config.httpClient
+	config.httpClient = &httpClient
+	config.endpoints = maps.Clone(config.endpoints)
+	config.retryableStatus = maps.Clone(config.retryableStatus)
+	config.expectedStatus = maps.Clone(config.expectedStatus)
+	config.sessionID = c.session()
+
+	for _, option := range options {
+		option(&config)
+	}
+
+	clone := newClient(&config)
+	clone.setToken(c.token())
+	// The clone's requests are still recorded, but only the client the
+	// recorder was configured on writes the archive
+	if config.harRecorder == c.config.harRecorder {
+		clone.harRecorder = nil
+	}
+	return clone
+}
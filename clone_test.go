@@ -0,0 +1,47 @@
+package kuvera
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestClientClone(t *testing.T) {
+	var auth []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		auth = append(auth, r.Header.Get("Authorization")+" "+r.Header.Get("X-Session-ID"))
+		w.Write([]byte(`{"status":"success"}`))
+	}, WithSessionID("session-1"))
+
+	clone := client.Clone(WithTimeout(2*time.Minute), WithUserAgent("heavy-calls/1.0")).(*Client)
+
+	if clone.httpClient == client.httpClient {
+		t.Fatal("clone shares the http.Client")
+	}
+	if clone.httpClient.Timeout != 2*time.Minute {
+		t.Errorf("clone Timeout = %v, want 2m", clone.httpClient.Timeout)
+	}
+	if client.httpClient.Timeout != DefaultTimeout {
+		t.Errorf("original Timeout = %v, want %v", client.httpClient.Timeout, DefaultTimeout)
+	}
+	if clone.userAgent != "heavy-calls/1.0" || client.userAgent != DefaultUserAgent {
+		t.Errorf("User-Agents = %q (clone), %q (original)", clone.userAgent, client.userAgent)
+	}
+	if clone.baseURL != client.baseURL {
+		t.Errorf("clone baseURL = %q, want %q", clone.baseURL, client.baseURL)
+	}
+
+	if _, err := clone.GetGoals(context.Background()); err != nil {
+		t.Fatalf("GetGoals() error = %v", err)
+	}
+	if want := "Bearer test-token session-1"; len(auth) != 1 || auth[0] != want {
+		t.Errorf("clone sent %v, want %q", auth, want)
+	}
+
+	// Later token changes on the original do not affect the clone
+	client.setToken("other-token")
+	if clone.token() != "test-token" {
+		t.Errorf("clone token = %q, want %q", clone.token(), "test-token")
+	}
+}
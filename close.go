@@ -0,0 +1,26 @@
+package kuvera
+
+// Close releases the resources held by the client: it closes the idle
+// connections of its HTTP transport and drops any cached responses.
+//
+// The client holds no background goroutines, so Close is only needed by
+// long-lived programs that create many clients. It is safe to call more than
+// once, and on a client that has made no requests. A closed client remains
+// usable; later requests open new connections.
+//
+// A client constructed with WithHARRecorder writes its archive on the first
+// Close, and any error doing so is returned.
+//
+// Idle connections belong to the transport, which a client shares with its
+// clones and, unless configured otherwise, with every user of
+// http.DefaultTransport. Closing them only costs those users a reconnect.
+func (c *Client) Close() error {
+	c.httpClient.CloseIdleConnections()
+	if c.cache != nil {
+		c.cache.clear()
+	}
+	if c.harRecorder != nil {
+		return c.harRecorder.flush()
+	}
+	return nil
+}
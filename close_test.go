@@ -0,0 +1,43 @@
+package kuvera
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestCloseUnused(t *testing.T) {
+	client := NewClient(WithCache(time.Minute))
+	for range 2 {
+		if err := client.Close(); err != nil {
+			t.Fatalf("Close() error = %v", err)
+		}
+	}
+}
+
+func TestClose(t *testing.T) {
+	calls := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Write([]byte(`{"status":"success","data":[]}`))
+	}, WithCache(time.Minute))
+
+	ctx := context.Background()
+	if _, err := client.GetGoals(ctx); err != nil {
+		t.Fatalf("GetGoals() error = %v", err)
+	}
+	for range 2 {
+		if err := client.Close(); err != nil {
+			t.Fatalf("Close() error = %v", err)
+		}
+	}
+
+	// The cache was dropped, and the client still works
+	if _, err := client.GetGoals(ctx); err != nil {
+		t.Fatalf("GetGoals() after Close error = %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("server called %d times, want 2", calls)
+	}
+}
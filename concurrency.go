@@ -0,0 +1,110 @@
+package kuvera
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// WithMaxConcurrentRequests caps the number of requests the client has in
+// flight at n. Further requests wait for one to finish, respecting context
+// cancellation, so that a bursty service sharing one client cannot flood
+// Kuvera. A request stays in flight until its response body is closed, which
+// every method does before returning. A non-positive n removes the cap.
+//
+// Like the rate limiter, the cap is shared with clients created by Clone.
+// Use InFlight to monitor how many requests are outstanding.
+func WithMaxConcurrentRequests(n int) ClientOption {
+	return func(c *clientConfig) {
+		if n <= 0 {
+			c.requestSlots = nil
+			return
+		}
+		c.requestSlots = make(chan struct{}, n)
+	}
+}
+
+// InFlight returns the number of requests the client currently has in flight,
+// not counting those waiting for WithMaxConcurrentRequests to admit them. It
+// is safe to call concurrently, for example to export it as a gauge.
+func (c *Client) InFlight() int {
+	return int(c.inFlight.Load())
+}
+
+// acquireRequestSlot waits until the request may be sent under the configured
+// concurrency cap, and counts it as in flight.
+func (c *Client) acquireRequestSlot(ctx context.Context) error {
+	if c.requestSlots != nil {
+		select {
+		case c.requestSlots <- struct{}{}:
+		case <-contextOrBackground(ctx).Done():
+			return fmt.Errorf("concurrency limit wait failed: %w", ctx.Err())
+		}
+	}
+	c.inFlight.Add(1)
+	return nil
+}
+
+// releaseRequestSlot marks a request acquired with acquireRequestSlot as done.
+func (c *Client) releaseRequestSlot() {
+	c.inFlight.Add(-1)
+	if c.requestSlots != nil {
+		<-c.requestSlots
+	}
+}
+
+// releaseOnClose releases a request slot once the response body is closed.
+type releaseOnClose struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releaseOnClose) Close() error {
+	defer b.once.Do(b.release)
+	return b.ReadCloser.Close()
+}
+
+// fanOut calls fn for each key, running at most limit calls at once, for
+// endpoints that serve one item per request. It returns the results of the
+// calls that succeeded and the errors of those that failed, both keyed by key.
+// Keys whose call has not started by the time ctx is done fail with ctx.Err().
+// fanOut returns only once every call it started has returned.
+func fanOut[T any](ctx context.Context, keys []string, limit int, fn func(ctx context.Context, key string) (T, error)) (map[string]T, map[string]error) {
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		results = make(map[string]T, len(keys))
+		errs    = make(map[string]error)
+	)
+	sem := make(chan struct{}, limit)
+	for _, key := range keys {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				mu.Lock()
+				errs[key] = ctx.Err()
+				mu.Unlock()
+				return
+			}
+
+			result, err := fn(ctx, key)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs[key] = err
+				return
+			}
+			results[key] = result
+		}()
+	}
+	wg.Wait()
+
+	return results, errs
+}
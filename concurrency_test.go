@@ -0,0 +1,126 @@
+package kuvera
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWithMaxConcurrentRequests(t *testing.T) {
+	const limit, requests = 2, 6
+
+	var (
+		mu      sync.Mutex
+		current int
+		maxSeen int
+		arrived = make(chan struct{}, requests)
+		proceed = make(chan struct{})
+	)
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		current++
+		maxSeen = max(maxSeen, current)
+		mu.Unlock()
+		arrived <- struct{}{}
+
+		<-proceed
+		mu.Lock()
+		current--
+		mu.Unlock()
+		w.Write([]byte(`{"status":"success"}`))
+	}, WithMaxConcurrentRequests(limit))
+
+	var wg sync.WaitGroup
+	for range requests {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.GetPortfolio(context.Background()); err != nil {
+				t.Errorf("GetPortfolio() error = %v", err)
+			}
+		}()
+	}
+
+	for range limit {
+		<-arrived
+	}
+	select {
+	case <-arrived:
+		t.Errorf("more than %d requests reached the server at once", limit)
+	case <-time.After(50 * time.Millisecond):
+	}
+	if got := client.InFlight(); got != limit {
+		t.Errorf("InFlight() = %d, want %d", got, limit)
+	}
+
+	close(proceed)
+	wg.Wait()
+
+	if maxSeen != limit {
+		t.Errorf("max concurrent requests = %d, want %d", maxSeen, limit)
+	}
+	if got := client.InFlight(); got != 0 {
+		t.Errorf("InFlight() after all requests = %d, want 0", got)
+	}
+}
+
+func TestWithMaxConcurrentRequestsContextDone(t *testing.T) {
+	proceed := make(chan struct{})
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		<-proceed
+		w.Write([]byte(`{"status":"success"}`))
+	}, WithMaxConcurrentRequests(1))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		client.GetPortfolio(context.Background())
+	}()
+	for client.InFlight() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := client.GetPortfolio(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("GetPortfolio() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	close(proceed)
+	<-done
+}
+
+func TestFanOut(t *testing.T) {
+	var active, peak atomic.Int32
+	keys := []string{"a", "b", "c", "d", "e", "f"}
+	results, errs := fanOut(context.Background(), keys, 2, func(ctx context.Context, key string) (string, error) {
+		n := active.Add(1)
+		defer active.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		if key == "c" {
+			return "", errors.New("boom")
+		}
+		return strings.ToUpper(key), nil
+	})
+
+	if peak.Load() > 2 {
+		t.Errorf("peak concurrency = %d, want at most 2", peak.Load())
+	}
+	if len(results) != 5 || results["a"] != "A" || results["f"] != "F" {
+		t.Errorf("results = %v, want the five successful keys", results)
+	}
+	if len(errs) != 1 || errs["c"] == nil {
+		t.Errorf("errs = %v, want only c", errs)
+	}
+}
@@ -0,0 +1,54 @@
+package kuvera
+
+import "context"
+
+// contextKey is the type of context keys defined by this package.
+type contextKey int
+
+const (
+	idempotencyKeyContextKey contextKey = iota
+	requestIDContextKey
+)
+
+// WithIdempotencyKey returns a copy of ctx carrying an idempotency key.
+//
+// Requests made with the returned context send the key in the Idempotency-Key
+// header, allowing the server to deduplicate writes that are retried.
+//
+// Example:
+//
+//	ctx = kuvera.WithIdempotencyKey(ctx, "order-2024-03-01")
+//	order, err := client.BuyGold(ctx, 1000)
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey, key)
+}
+
+// idempotencyKeyFromContext returns the idempotency key carried by ctx, if any.
+func idempotencyKeyFromContext(ctx context.Context) (string, bool) {
+	key, ok := ctx.Value(idempotencyKeyContextKey).(string)
+	return key, ok && key != ""
+}
+
+// WithRequestID returns a copy of ctx carrying a request ID.
+//
+// Requests made with the returned context send the ID in the X-Request-ID
+// header and log it, overriding the ID generated by WithRequestIDs. Use it to
+// correlate client calls with an ID from an incoming request.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDContextKey, id)
+}
+
+// requestIDFromContext returns the request ID carried by ctx, if any.
+func requestIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIDContextKey).(string)
+	return id, ok && id != ""
+}
+
+// contextOrBackground returns ctx, or context.Background() if ctx is nil, so
+// that a nil context does not panic deep inside net/http.
+func contextOrBackground(ctx context.Context) context.Context {
+	if ctx == nil {
+		return context.Background()
+	}
+	return ctx
+}
@@ -0,0 +1,48 @@
+package kuvera
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestWithIdempotencyKey(t *testing.T) {
+	var got []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Get("Idempotency-Key"))
+		if _, ok := r.Header["Idempotency-Key"]; !ok {
+			got[len(got)-1] = "<absent>"
+		}
+		w.Write([]byte(`{"status":"success"}`))
+	})
+
+	ctx := context.Background()
+	if err := client.Ping(WithIdempotencyKey(ctx, "order-123")); err != nil {
+		t.Fatalf("Ping() error = %v", err)
+	}
+	if err := client.Ping(ctx); err != nil {
+		t.Fatalf("Ping() error = %v", err)
+	}
+
+	if len(got) != 2 || got[0] != "order-123" || got[1] != "<absent>" {
+		t.Errorf("Idempotency-Key headers = %q, want [order-123 <absent>]", got)
+	}
+}
+
+func TestNilContext(t *testing.T) {
+	for _, option := range []ClientOption{WithTimeout(0), WithTimeout(time.Second)} {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"status":"success","data":{"current_value":1234.5}}`))
+		}, option)
+
+		var ctx context.Context
+		portfolio, err := client.GetPortfolio(ctx)
+		if err != nil {
+			t.Fatalf("GetPortfolio(nil) error = %v", err)
+		}
+		if portfolio.Data.CurrentValue != 1234.5 {
+			t.Errorf("CurrentValue = %v, want 1234.5", portfolio.Data.CurrentValue)
+		}
+	}
+}
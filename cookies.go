@@ -0,0 +1,24 @@
+package kuvera
+
+import "net/http"
+
+// WithCookieJar sets the cookie jar used to store cookies set by Kuvera, such
+// as session cookies returned at login, and send them on later requests.
+//
+// By default the client uses an in-memory jar. It applies to the client set
+// with WithHTTPClient regardless of option order; the provided client is copied
+// rather than modified. Without this option, a client set with WithHTTPClient
+// keeps its own Jar, if any.
+func WithCookieJar(jar http.CookieJar) ClientOption {
+	return func(c *clientConfig) {
+		c.cookieJar = jar
+	}
+}
+
+// applyCookieJar replaces the configured HTTP client with a copy using the
+// configured cookie jar.
+func (c *clientConfig) applyCookieJar() {
+	httpClient := *c.httpClient
+	httpClient.Jar = c.cookieJar
+	c.httpClient = &httpClient
+}
@@ -0,0 +1,61 @@
+package kuvera
+
+import (
+	"context"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func cookieHandler(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v5/users/authenticate.json":
+			http.SetCookie(w, &http.Cookie{Name: "_kuvera_session", Value: "cookie-123", Path: "/"})
+			w.Write([]byte(`{"status":"success","token":"jwt-token"}`))
+		default:
+			cookie, err := r.Cookie("_kuvera_session")
+			if err != nil || cookie.Value != "cookie-123" {
+				t.Errorf("session cookie = %v (error: %v), want cookie-123", cookie, err)
+			}
+			w.Write([]byte(`{"status":"success"}`))
+		}
+	}
+}
+
+func TestCookiesPersistAfterLogin(t *testing.T) {
+	client := newTestClient(t, cookieHandler(t))
+	client.accessToken = ""
+
+	if _, err := client.Login(context.Background(), "user@example.com", "secret"); err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+	if _, err := client.GetGoals(context.Background()); err != nil {
+		t.Fatalf("GetGoals() error = %v", err)
+	}
+}
+
+func TestWithCookieJar(t *testing.T) {
+	jar, _ := cookiejar.New(nil)
+	custom := &http.Client{Timeout: 5 * time.Second}
+	// Option order must not matter
+	client := newTestClient(t, cookieHandler(t), WithCookieJar(jar), WithHTTPClient(custom))
+	client.accessToken = ""
+
+	if _, err := client.Login(context.Background(), "user@example.com", "secret"); err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+	if _, err := client.GetGoals(context.Background()); err != nil {
+		t.Fatalf("GetGoals() error = %v", err)
+	}
+
+	if custom.Jar != nil {
+		t.Error("WithCookieJar modified the provided client")
+	}
+	u, _ := url.Parse(client.baseURL)
+	if cookies := jar.Cookies(u); len(cookies) != 1 || cookies[0].Value != "cookie-123" {
+		t.Errorf("jar cookies = %v, want the session cookie", cookies)
+	}
+}
@@ -0,0 +1,46 @@
+package kuvera
+
+import (
+	"context"
+	"fmt"
+)
+
+// CredentialProvider supplies login credentials on demand, for example from
+// Vault or AWS Secrets Manager, so that the application never has to hold the
+// password itself.
+type CredentialProvider interface {
+	// Credentials returns the username and password to log in with
+	Credentials(ctx context.Context) (username, password string, err error)
+}
+
+// CredentialProviderFunc adapts an ordinary function to a CredentialProvider.
+type CredentialProviderFunc func(ctx context.Context) (username, password string, err error)
+
+// Credentials calls f(ctx).
+func (f CredentialProviderFunc) Credentials(ctx context.Context) (string, string, error) {
+	return f(ctx)
+}
+
+// LoginWithProvider fetches credentials from provider and logs in with them,
+// as Login does. The credentials are not retained by the client.
+//
+// If the provider fails, its error is returned wrapped and no login request is
+// made.
+//
+// Example:
+//
+//	provider := kuvera.CredentialProviderFunc(func(ctx context.Context) (string, string, error) {
+//		secret, err := vault.Read(ctx, "secret/kuvera")
+//		if err != nil {
+//			return "", "", err
+//		}
+//		return secret.Username, secret.Password, nil
+//	})
+//	resp, err := client.LoginWithProvider(ctx, provider)
+func (c *Client) LoginWithProvider(ctx context.Context, provider CredentialProvider) (*LoginResponse, error) {
+	username, password, err := provider.Credentials(contextOrBackground(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("credential provider failed: %w", err)
+	}
+	return c.Login(ctx, username, password)
+}
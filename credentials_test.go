@@ -0,0 +1,46 @@
+package kuvera
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestLoginWithProvider(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var req LoginRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("failed to decode login request: %v", err)
+		}
+		if req.Email != "user@example.com" || req.Password != "from-vault" {
+			t.Errorf("login credentials = %q/%q, want the provider's", req.Email, req.Password)
+		}
+		w.Write([]byte(`{"status":"success","token":"fresh-token"}`))
+	})
+
+	provider := CredentialProviderFunc(func(ctx context.Context) (string, string, error) {
+		return "user@example.com", "from-vault", nil
+	})
+	if _, err := client.LoginWithProvider(context.Background(), provider); err != nil {
+		t.Fatalf("LoginWithProvider() error = %v", err)
+	}
+	if got := client.token(); got != "fresh-token" {
+		t.Errorf("token = %q, want %q", got, "fresh-token")
+	}
+}
+
+func TestLoginWithProviderError(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request expected when the provider fails")
+	})
+
+	errSealed := errors.New("vault is sealed")
+	provider := CredentialProviderFunc(func(ctx context.Context) (string, string, error) {
+		return "", "", errSealed
+	})
+	if _, err := client.LoginWithProvider(context.Background(), provider); !errors.Is(err, errSealed) {
+		t.Errorf("LoginWithProvider() error = %v, want %v", err, errSealed)
+	}
+}
@@ -0,0 +1,136 @@
+package kuvera
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Currency conversion errors
+var (
+	ErrInvalidFXRate     = errors.New("FX rate must be a positive number")
+	ErrNoDisplayCurrency = errors.New("no display currency: construct the client with WithDisplayCurrency")
+)
+
+// ConvertedPortfolio is a portfolio whose monetary values have been converted
+// from INR to another currency.
+type ConvertedPortfolio struct {
+	// Currency is the currency code of the converted values, such as "USD"
+	Currency string
+	// FXRate is the rate used for the conversion, in Currency per INR
+	FXRate float64
+	// Data contains the converted portfolio data
+	Data PortfolioData
+}
+
+// WithDisplayCurrency sets the currency, such as "USD", that
+// GetPortfolioInCurrency reports its converted values in. The code is
+// recorded as given, upper-cased; the client does not look up exchange rates.
+func WithDisplayCurrency(code string) ClientOption {
+	return func(c *clientConfig) {
+		c.displayCurrency = strings.ToUpper(strings.TrimSpace(code))
+	}
+}
+
+// GetPortfolioInCurrency retrieves the portfolio with GetPortfolio and converts
+// its INR values to the display currency set with WithDisplayCurrency, by
+// multiplying them by fxRate (units of the display currency per rupee).
+//
+// Only monetary values are converted; see PortfolioData.ConvertCurrency. The
+// FX rate must be positive, and the client must have a display currency,
+// otherwise ErrInvalidFXRate or ErrNoDisplayCurrency is returned. The user must
+// be authenticated (logged in) before calling this method.
+//
+// Example:
+//
+//	client := kuvera.NewClient(kuvera.WithDisplayCurrency("USD"))
+//	// ... log in
+//	portfolio, err := client.GetPortfolioInCurrency(ctx, 1/83.2)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Total value: %.2f %s\n", portfolio.Data.CurrentValue, portfolio.Currency)
+func (c *Client) GetPortfolioInCurrency(ctx context.Context, fxRate float64) (*ConvertedPortfolio, error) {
+	if !(fxRate > 0) || math.IsInf(fxRate, 0) {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFXRate, fxRate)
+	}
+	if c.displayCurrency == "" {
+		return nil, ErrNoDisplayCurrency
+	}
+
+	portfolio, err := c.GetPortfolio(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ConvertedPortfolio{
+		Currency: c.displayCurrency,
+		FXRate:   fxRate,
+		Data:     portfolio.Data.ConvertCurrency(fxRate),
+	}, nil
+}
+
+// ConvertCurrency returns a copy of the portfolio with every monetary value
+// multiplied by rate. Percentages, XIRRs and gold quantities are left as they
+// are. The receiver is not modified.
+func (p PortfolioData) ConvertCurrency(rate float64) PortfolioData {
+	p.CurrentValue *= rate
+	p.CurrentGain *= rate
+	p.CurrentValueAssets *= rate
+	p.OneDayGain *= rate
+	p.Invested *= rate
+	p.InvestedValueAssets *= rate
+	p.AlltimeReturn *= rate
+	p.AlltimeAbsReturn *= rate
+
+	p.USEquities.CurrentValue *= rate
+	p.USEquities.TotalInvested *= rate
+	p.USEquities.OneDayChange *= rate
+
+	p.EPF.CurrentValue *= rate
+	p.EPF.TotalInvested *= rate
+	p.EPF.EmployeeContribution *= rate
+	p.EPF.EmployerContribution *= rate
+
+	p.Gold.OneDayChange *= rate
+	p.Gold.CurrentValue *= rate
+	p.Gold.TotalInvested *= rate
+	p.Gold.Kuvera.OneDayChange *= rate
+	p.Gold.Kuvera.InvestedValue *= rate
+	p.Gold.Kuvera.CurrentValue *= rate
+	p.Gold.Kuvera.ProfitAmount *= rate
+	p.Gold.Imported.OneDayChange *= rate
+	p.Gold.Imported.InvestedValue *= rate
+	p.Gold.Imported.CurrentValue *= rate
+	p.Gold.Imported.ProfitAmount *= rate
+
+	p.IndianEquities.OneDayChange *= rate
+	p.IndianEquities.CurrentValue *= rate
+	p.IndianEquities.TotalInvested *= rate
+
+	p.MutualFunds.OneDayChange *= rate
+	p.MutualFunds.CurrentValue *= rate
+	p.MutualFunds.TotalInvested *= rate
+
+	p.SaveSmarts.CurrentValue *= rate
+	p.SaveSmarts.TotalInvested *= rate
+	p.SaveSmarts.OneDayChange *= rate
+
+	p.FixedDeposit.CurrentValue *= rate
+	p.FixedDeposit.TotalInvested *= FlexFloat(rate)
+	p.FixedDeposit.OneDayChange *= rate
+	if p.FixedDeposit.FDDetails != nil {
+		details := make([]FDDetails, len(p.FixedDeposit.FDDetails))
+		for i, fd := range p.FixedDeposit.FDDetails {
+			fd.Invested *= FlexFloat(rate)
+			fd.CurrentValue *= rate
+			fd.OneDayChange *= rate
+			details[i] = fd
+		}
+		p.FixedDeposit.FDDetails = details
+	}
+
+	return p
+}
@@ -0,0 +1,102 @@
+package kuvera
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"testing"
+)
+
+func TestConvertCurrency(t *testing.T) {
+	p := PortfolioData{
+		CurrentValue:         8300,
+		Invested:             6640,
+		OneDayGain:           -83,
+		CurrentGainPercent:   25,
+		OneDayGainPercent:    -1,
+		CurrentXIRR:          12.5,
+		AlltimeAbsPercentage: 25,
+		Gold:                 GoldData{CurrentValue: 830, TotalGoldQuantity: 0.12, XIRR: "8.1"},
+		MutualFunds:          MutualFundsData{CurrentValue: 4150, XIRRPercentage: 14, AbsolutePercentage: 30},
+		FixedDeposit: FixedDepositData{
+			TotalInvested: 1660,
+			XIRR:          7.1,
+			FDDetails:     []FDDetails{{Invested: 1660, CurrentValue: 1743}},
+		},
+	}
+
+	got := p.ConvertCurrency(0.01)
+
+	converted := map[string][2]float64{
+		"CurrentValue":                           {got.CurrentValue, 83},
+		"Invested":                               {got.Invested, 66.4},
+		"OneDayGain":                             {got.OneDayGain, -0.83},
+		"Gold.CurrentValue":                      {got.Gold.CurrentValue, 8.3},
+		"MutualFunds.CurrentValue":               {got.MutualFunds.CurrentValue, 41.5},
+		"FixedDeposit.TotalInvested":             {got.FixedDeposit.TotalInvested.Float64(), 16.6},
+		"FixedDeposit.FDDetails[0].Invested":     {got.FixedDeposit.FDDetails[0].Invested.Float64(), 16.6},
+		"FixedDeposit.FDDetails[0].CurrentValue": {got.FixedDeposit.FDDetails[0].CurrentValue, 17.43},
+	}
+	unchanged := map[string][2]float64{
+		"CurrentGainPercent":             {got.CurrentGainPercent, 25},
+		"OneDayGainPercent":              {got.OneDayGainPercent, -1},
+		"CurrentXIRR":                    {got.CurrentXIRR, 12.5},
+		"AlltimeAbsPercentage":           {got.AlltimeAbsPercentage, 25},
+		"Gold.TotalGoldQuantity":         {got.Gold.TotalGoldQuantity, 0.12},
+		"MutualFunds.XIRRPercentage":     {got.MutualFunds.XIRRPercentage, 14},
+		"MutualFunds.AbsolutePercentage": {got.MutualFunds.AbsolutePercentage, 30},
+		"FixedDeposit.XIRR":              {got.FixedDeposit.XIRR, 7.1},
+	}
+	for _, fields := range []map[string][2]float64{converted, unchanged} {
+		for name, v := range fields {
+			if math.Abs(v[0]-v[1]) > 1e-9 {
+				t.Errorf("%s = %v, want %v", name, v[0], v[1])
+			}
+		}
+	}
+	if got.Gold.XIRR != "8.1" {
+		t.Errorf("Gold.XIRR = %q, want 8.1", got.Gold.XIRR)
+	}
+
+	// The receiver, including its FD details, is left untouched
+	if p.CurrentValue != 8300 || p.FixedDeposit.FDDetails[0].CurrentValue != 1743 {
+		t.Errorf("ConvertCurrency modified the receiver: %+v", p)
+	}
+}
+
+func TestGetPortfolioInCurrency(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":"success","data":{"current_value":8300,"current_gain_percent":25}}`))
+	}, WithDisplayCurrency("usd"))
+
+	portfolio, err := client.GetPortfolioInCurrency(context.Background(), 0.012)
+	if err != nil {
+		t.Fatalf("GetPortfolioInCurrency() error = %v", err)
+	}
+	if portfolio.Currency != "USD" || portfolio.FXRate != 0.012 {
+		t.Errorf("Currency, FXRate = %q, %v, want USD, 0.012", portfolio.Currency, portfolio.FXRate)
+	}
+	if math.Abs(portfolio.Data.CurrentValue-99.6) > 1e-9 || portfolio.Data.CurrentGainPercent != 25 {
+		t.Errorf("Data = %+v, want current value 99.6 and gain 25%%", portfolio.Data)
+	}
+}
+
+func TestGetPortfolioInCurrencyValidation(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request expected")
+	}, WithDisplayCurrency("USD"))
+
+	for _, rate := range []float64{0, -0.012, math.NaN(), math.Inf(1)} {
+		if _, err := client.GetPortfolioInCurrency(context.Background(), rate); !errors.Is(err, ErrInvalidFXRate) {
+			t.Errorf("GetPortfolioInCurrency(%v) error = %v, want %v", rate, err, ErrInvalidFXRate)
+		}
+	}
+
+	noCurrency := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request expected")
+	})
+	if _, err := noCurrency.GetPortfolioInCurrency(context.Background(), 0.012); !errors.Is(err, ErrNoDisplayCurrency) {
+		t.Errorf("GetPortfolioInCurrency() error = %v, want %v", err, ErrNoDisplayCurrency)
+	}
+}
@@ -0,0 +1,54 @@
+package kuvera
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// kuveraDateLayouts are the date formats observed in Kuvera responses.
+var kuveraDateLayouts = []string{
+	"2006-01-02",
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.000Z0700",
+	"2006-01-02 15:04:05",
+	"02-01-2006",
+	"02/01/2006",
+}
+
+// parseKuveraDate parses a date in any of the formats Kuvera uses.
+func parseKuveraDate(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	for _, layout := range kuveraDateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q", s)
+}
+
+// Date returns the parsed order date.
+func (o OrderDetail) Date() (time.Time, error) {
+	return parseKuveraDate(o.OrderDate)
+}
+
+// Start returns the parsed SIP start date.
+func (s SIPDetail) Start() (time.Time, error) {
+	return parseKuveraDate(s.StartDate)
+}
+
+// End returns the parsed SIP end date. Ongoing SIPs have no end date, in which
+// case the zero time and a nil error are returned.
+func (s SIPDetail) End() (time.Time, error) {
+	switch end := s.EndDate.(type) {
+	case nil:
+		return time.Time{}, nil
+	case string:
+		if strings.TrimSpace(end) == "" {
+			return time.Time{}, nil
+		}
+		return parseKuveraDate(end)
+	default:
+		return time.Time{}, fmt.Errorf("invalid end date %v", end)
+	}
+}
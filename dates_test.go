@@ -0,0 +1,67 @@
+package kuvera
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOrderDetailDate(t *testing.T) {
+	tests := []struct {
+		orderDate string
+		want      time.Time
+		wantErr   bool
+	}{
+		{"2023-01-05", time.Date(2023, 1, 5, 0, 0, 0, 0, time.UTC), false},
+		{"2023-01-05T10:30:00Z", time.Date(2023, 1, 5, 10, 30, 0, 0, time.UTC), false},
+		{"2023-01-05 10:30:00", time.Date(2023, 1, 5, 10, 30, 0, 0, time.UTC), false},
+		{"05-01-2023", time.Date(2023, 1, 5, 0, 0, 0, 0, time.UTC), false},
+		{"", time.Time{}, true},
+		{"2023-13-45", time.Time{}, true},
+		{"yesterday", time.Time{}, true},
+	}
+
+	for _, tt := range tests {
+		got, err := OrderDetail{OrderDate: tt.orderDate}.Date()
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Date(%q) error = %v, wantErr %t", tt.orderDate, err, tt.wantErr)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("Date(%q) = %v, want %v", tt.orderDate, got, tt.want)
+		}
+	}
+}
+
+func TestSIPDetailStartEnd(t *testing.T) {
+	sip := SIPDetail{StartDate: "2022-04-10", EndDate: "2032-04-10"}
+
+	start, err := sip.Start()
+	if err != nil || !start.Equal(time.Date(2022, 4, 10, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Start() = %v, %v", start, err)
+	}
+	end, err := sip.End()
+	if err != nil || !end.Equal(time.Date(2032, 4, 10, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("End() = %v, %v", end, err)
+	}
+}
+
+func TestSIPDetailEndOngoing(t *testing.T) {
+	for _, endDate := range []interface{}{nil, ""} {
+		end, err := SIPDetail{EndDate: endDate}.End()
+		if err != nil || !end.IsZero() {
+			t.Errorf("End() with EndDate %#v = %v, %v; want zero time and nil error", endDate, end, err)
+		}
+	}
+}
+
+func TestSIPDetailMalformed(t *testing.T) {
+	if _, err := (SIPDetail{StartDate: "10th April"}).Start(); err == nil {
+		t.Error("Start() error = nil for a malformed date")
+	}
+	if _, err := (SIPDetail{EndDate: "2032/04/10"}).End(); err == nil {
+		t.Error("End() error = nil for a malformed date")
+	}
+	if _, err := (SIPDetail{EndDate: 20320410.0}).End(); err == nil {
+		t.Error("End() error = nil for a non-string end date")
+	}
+}
@@ -0,0 +1,85 @@
+package kuvera
+
+import (
+	"context"
+	"fmt"
+)
+
+// DividendRecord represents a single dividend (IDCW) payout for a fund.
+type DividendRecord struct {
+	// FundCode is the code of the fund that paid the dividend
+	FundCode string `json:"fund_code"`
+	// FolioNumber is the folio the dividend was paid to
+	FolioNumber string `json:"folio_number"`
+	// RecordDate is the dividend record date
+	RecordDate string `json:"record_date"`
+	// AmountPerUnit is the dividend declared per unit
+	AmountPerUnit float64 `json:"amount_per_unit"`
+	// Units is the number of units held on the record date
+	Units float64 `json:"units"`
+	// TotalPayout is the total dividend amount
+	TotalPayout float64 `json:"total_payout"`
+	// Reinvested indicates if the payout was reinvested rather than paid out
+	Reinvested bool `json:"reinvested"`
+	// ReinvestNAV is the NAV at which the payout was reinvested (zero for cash payouts)
+	ReinvestNAV float64 `json:"reinvest_nav"`
+	// ReinvestUnits is the number of units allotted on reinvestment (zero for cash payouts)
+	ReinvestUnits float64 `json:"reinvest_units"`
+}
+
+// DividendsResponse represents the response from the dividends API endpoint.
+type DividendsResponse struct {
+	// Status indicates if the request was successful
+	Status string `json:"status"`
+	// Data contains the payout records
+	Data []DividendRecord `json:"data"`
+}
+
+// GetDividends retrieves the dividend (IDCW) payout history for all funds.
+//
+// Reinvested payouts also appear in GetHoldings as order details with a
+// ReinvestAmount; Holding.ReinvestedDividends sums those for reconciliation.
+// The user must be authenticated (logged in) before calling this method.
+//
+// Returns:
+//   - DividendsResponse: Contains the payout records per fund
+//   - error: Authentication errors, network errors, or API errors
+//
+// Example:
+//
+//	dividends, err := client.GetDividends(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, d := range dividends.Data {
+//		fmt.Printf("%s %s: ₹%.2f (reinvested: %t)\n", d.RecordDate, d.FundCode, d.TotalPayout, d.Reinvested)
+//	}
+func (c *Client) GetDividends(ctx context.Context) (*DividendsResponse, error) {
+	if c.token() == "" {
+		return nil, ErrNotAuthenticated
+	}
+
+	resp, err := c.makeRequest(ctx, "GET", "/api/v3/portfolio/dividends.json", nil)
+	if err != nil {
+		return nil, fmt.Errorf("dividends request failed: %w", err)
+	}
+
+	var dividendsResp DividendsResponse
+	if err := c.handleResponse(resp, &dividendsResp, "dividends"); err != nil {
+		return &dividendsResp, err
+	}
+
+	return &dividendsResp, nil
+}
+
+// ReinvestedDividends returns the total dividend amount reinvested into the
+// holding, summed from the ReinvestAmount of its order details.
+func (h Holding) ReinvestedDividends() float64 {
+	var total float64
+	for _, order := range h.OrderDetails {
+		if order.ReinvestAmount != nil {
+			total += *order.ReinvestAmount
+		}
+	}
+	return total
+}
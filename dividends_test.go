@@ -0,0 +1,56 @@
+package kuvera
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestGetDividends(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v3/portfolio/dividends.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"status":"success","data":[
+			{"fund_code":"ICICI-IDCW","folio_number":"123","record_date":"2024-02-15","amount_per_unit":1.5,"units":200,"total_payout":300,"reinvested":true,"reinvest_nav":25,"reinvest_units":12},
+			{"fund_code":"HDFC-IDCW","folio_number":"456","record_date":"2024-03-01","amount_per_unit":0.75,"units":400,"total_payout":300,"reinvested":false}
+		]}`))
+	})
+
+	dividends, err := client.GetDividends(context.Background())
+	if err != nil {
+		t.Fatalf("GetDividends() error = %v", err)
+	}
+	if len(dividends.Data) != 2 {
+		t.Fatalf("got %d records, want 2", len(dividends.Data))
+	}
+
+	reinvest := dividends.Data[0]
+	if !reinvest.Reinvested || reinvest.TotalPayout != 300 || reinvest.ReinvestUnits != 12 {
+		t.Errorf("unexpected reinvest record: %+v", reinvest)
+	}
+
+	cash := dividends.Data[1]
+	if cash.Reinvested || cash.AmountPerUnit != 0.75 || cash.ReinvestUnits != 0 {
+		t.Errorf("unexpected cash payout record: %+v", cash)
+	}
+}
+
+func TestHoldingReinvestedDividends(t *testing.T) {
+	var holding Holding
+	body := []byte(`{"order_details":[
+		{"amount":5000,"reinvest_amount":null,"nav":20,"units":250,"order_date":"2023-06-01"},
+		{"amount":300,"reinvest_amount":300,"nav":25,"units":12,"order_date":"2024-02-15"}
+	]}`)
+	if err := json.Unmarshal(body, &holding); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+
+	if holding.OrderDetails[0].ReinvestAmount != nil {
+		t.Errorf("regular purchase ReinvestAmount = %v, want nil", *holding.OrderDetails[0].ReinvestAmount)
+	}
+	if got := holding.ReinvestedDividends(); got != 300 {
+		t.Errorf("ReinvestedDividends() = %v, want 300", got)
+	}
+}
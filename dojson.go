@@ -0,0 +1,45 @@
+package kuvera
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// DoJSON is a low-level escape hatch for calling Kuvera endpoints that this
+// library does not wrap. It sends body to endpoint and decodes the response
+// into out, going through the same machinery as the typed methods: the stored
+// access token, default headers, retries, rate limiting, caching and hooks all
+// apply.
+//
+// endpoint is a path relative to the base URL, optionally with a query string,
+// such as "/api/v3/notifications.json?page=2". body is encoded as JSON unless
+// it is nil or url.Values (sent as a form). out may be nil to discard the
+// response. Error responses are reported as by the typed methods, typically as
+// an *APIError.
+//
+// DoJSON does not check that the client is logged in, since some endpoints
+// are public. Prefer the typed methods where they exist: the endpoints and
+// response shapes used here are not covered by this library's compatibility
+// guarantees.
+//
+// Example:
+//
+//	var notifications struct {
+//		Data []struct {
+//			Title string `json:"title"`
+//		} `json:"data"`
+//	}
+//	if err := client.DoJSON(ctx, "GET", "/api/v3/notifications.json", nil, &notifications); err != nil {
+//		log.Fatal(err)
+//	}
+func (c *Client) DoJSON(ctx context.Context, method, endpoint string, body, out interface{}) error {
+	method = strings.ToUpper(method)
+	operation := method + " " + endpointLabel(endpoint)
+
+	resp, err := c.makeRequest(ctx, method, endpoint, body)
+	if err != nil {
+		return fmt.Errorf("%s request failed: %w", operation, err)
+	}
+	return c.handleResponse(resp, out, operation)
+}
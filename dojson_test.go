@@ -0,0 +1,54 @@
+package kuvera
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestDoJSON(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/v3/notifications/read.json" || r.URL.Query().Get("all") != "true" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer test-token" {
+			t.Errorf("Authorization = %q, want the stored token", got)
+		}
+		var req struct {
+			IDs []int `json:"ids"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || len(req.IDs) != 2 {
+			t.Errorf("request body = %+v, %v", req, err)
+		}
+		w.Write([]byte(`{"status":"success","data":{"unread":3,"latest":"SIP installment processed"}}`))
+	})
+
+	var out struct {
+		Data struct {
+			Unread int    `json:"unread"`
+			Latest string `json:"latest"`
+		} `json:"data"`
+	}
+	body := map[string][]int{"ids": {1, 2}}
+	if err := client.DoJSON(context.Background(), "post", "/api/v3/notifications/read.json?all=true", body, &out); err != nil {
+		t.Fatalf("DoJSON() error = %v", err)
+	}
+	if out.Data.Unread != 3 || out.Data.Latest != "SIP installment processed" {
+		t.Errorf("out = %+v", out)
+	}
+}
+
+func TestDoJSONError(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"code":400,"message":"unknown report"}`))
+	})
+
+	err := client.DoJSON(context.Background(), "GET", "/api/v3/reports/nope.json", nil, nil)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Message != "unknown report" {
+		t.Errorf("DoJSON() error = %v, want an *APIError", err)
+	}
+}
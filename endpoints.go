@@ -0,0 +1,43 @@
+package kuvera
+
+// defaultEndpoints are the API paths used unless overridden with WithEndpoint.
+var defaultEndpoints = map[string]string{
+	"login":     "/api/v5/users/authenticate.json",
+	"portfolio": "/api/v5/portfolio/returns.json",
+	"holdings":  "/api/v3/portfolio/holdings.json",
+	"gold":      "/api/v3/gold/current_price.json",
+}
+
+// WithEndpoint overrides the API path used for an operation, so that the
+// client keeps working if Kuvera moves an endpoint before a new release of
+// this library is available.
+//
+// name is one of "login", "portfolio", "holdings" or "gold"; other names are
+// ignored. path is relative to the base URL and must not include a query
+// string, since the client appends its own query parameters. The "portfolio"
+// path is also used by GetPortfolioAsOf and GetPortfolioForAccount.
+//
+// Example:
+//
+//	client := kuvera.NewClient(
+//		kuvera.WithEndpoint("portfolio", "/api/v6/portfolio/returns.json"),
+//	)
+func WithEndpoint(name, path string) ClientOption {
+	return func(c *clientConfig) {
+		if _, ok := defaultEndpoints[name]; !ok {
+			return
+		}
+		if c.endpoints == nil {
+			c.endpoints = make(map[string]string)
+		}
+		c.endpoints[name] = path
+	}
+}
+
+// endpoint returns the API path for the named operation.
+func (c *Client) endpoint(name string) string {
+	if path, ok := c.endpoints[name]; ok {
+		return path
+	}
+	return defaultEndpoints[name]
+}
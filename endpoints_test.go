@@ -0,0 +1,39 @@
+package kuvera
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestWithEndpoint(t *testing.T) {
+	var paths []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Write([]byte(`{"status":"success","data":{"current_value":1000}}`))
+	}, WithEndpoint("portfolio", "/api/v6/portfolio/summary.json"), WithEndpoint("unknown", "/ignored.json"))
+
+	if _, err := client.GetPortfolio(context.Background()); err != nil {
+		t.Fatalf("GetPortfolio() error = %v", err)
+	}
+	if _, err := client.GetPortfolioForAccount(context.Background(), "FAM123"); err != nil {
+		t.Fatalf("GetPortfolioForAccount() error = %v", err)
+	}
+	if _, err := client.GetGoldPrice(context.Background()); err != nil {
+		t.Fatalf("GetGoldPrice() error = %v", err)
+	}
+
+	want := []string{
+		"/api/v6/portfolio/summary.json",
+		"/api/v6/portfolio/summary.json",
+		"/api/v3/gold/current_price.json",
+	}
+	for i := range want {
+		if paths[i] != want[i] {
+			t.Errorf("request %d path = %q, want %q", i, paths[i], want[i])
+		}
+	}
+	if _, ok := client.endpoints["unknown"]; ok {
+		t.Error("unknown endpoint name should be ignored")
+	}
+}
@@ -0,0 +1,89 @@
+package kuvera
+
+import (
+	"context"
+	"fmt"
+)
+
+// EnrichedHolding is a holding together with its fund's latest NAV and the
+// holding's value at that NAV.
+type EnrichedHolding struct {
+	Holding
+	// NAV is the fund's latest NAV (zero if the lookup failed)
+	NAV float64 `json:"nav"`
+	// NAVDate is the date of NAV
+	NAVDate string `json:"nav_date"`
+	// CurrentValue is the holding's units valued at NAV (zero if the lookup failed)
+	CurrentValue float64 `json:"current_value"`
+}
+
+// EnrichedHoldingsResponse is the result of GetEnrichedHoldings.
+type EnrichedHoldingsResponse struct {
+	// Data contains the enriched holdings keyed by fund code
+	Data map[string][]EnrichedHolding `json:"data"`
+	// NAVErrors records why the NAV lookup failed, keyed by fund code; the
+	// holdings of those funds have a zero NAV and CurrentValue
+	NAVErrors map[string]error `json:"-"`
+}
+
+// GetEnrichedHoldings retrieves the holdings together with the latest NAV of
+// each fund held, and values every holding at that NAV.
+//
+// The NAVs come from GetFundDetails, one request per fund, made concurrently a
+// few at a time. A failed lookup does not fail the call: the fund's holdings
+// are left with a zero NAV and the error is recorded in NAVErrors. An error is
+// returned if the holdings cannot be fetched, or along with the partial result
+// if ctx is done before every NAV is fetched. The user must be authenticated
+// (logged in) before calling this method.
+//
+// Example:
+//
+//	enriched, err := client.GetEnrichedHoldings(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for code, holdings := range enriched.Data {
+//		for _, holding := range holdings {
+//			fmt.Printf("%s: ₹%.2f\n", code, holding.CurrentValue)
+//		}
+//	}
+//	for code, err := range enriched.NAVErrors {
+//		log.Printf("no NAV for %s: %v", code, err)
+//	}
+func (c *Client) GetEnrichedHoldings(ctx context.Context) (*EnrichedHoldingsResponse, error) {
+	ctx = contextOrBackground(ctx)
+	holdings, err := c.GetHoldings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	codes := holdings.FundCodes()
+	navs, navErrors := fanOut(ctx, codes, navConcurrency, func(ctx context.Context, code string) (FundNAV, error) {
+		fund, err := c.GetFundDetails(ctx, code)
+		if err != nil {
+			return FundNAV{}, err
+		}
+		return fund.NAV, nil
+	})
+	enriched := &EnrichedHoldingsResponse{
+		Data:      make(map[string][]EnrichedHolding, len(codes)),
+		NAVErrors: navErrors,
+	}
+
+	for code, fundHoldings := range *holdings {
+		nav := navs[code]
+		for _, holding := range fundHoldings {
+			enriched.Data[code] = append(enriched.Data[code], EnrichedHolding{
+				Holding:      holding,
+				NAV:          nav.NAV,
+				NAVDate:      nav.Date,
+				CurrentValue: holding.Units * nav.NAV,
+			})
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return enriched, fmt.Errorf("enriching holdings: %w", err)
+	}
+	return enriched, nil
+}
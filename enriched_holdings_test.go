@@ -0,0 +1,86 @@
+package kuvera
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// enrichedHoldingsHandler serves two holdings of AFUND and one of BFUND, with
+// the NAVs in navs; funds without a NAV are not found.
+func enrichedHoldingsHandler(t *testing.T, navs map[string]string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v3/portfolio/holdings.json":
+			w.Write([]byte(`{"AFUND":[{"folioNumber":"1","units":10},{"folioNumber":"2","units":4}],
+				"BFUND":[{"folioNumber":"3","units":2}]}`))
+		case "/mf/api/v5/fund_schemes/AFUND.json", "/mf/api/v5/fund_schemes/BFUND.json":
+			code := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/mf/api/v5/fund_schemes/"), ".json")
+			nav, ok := navs[code]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write([]byte(`[{"code":"` + code + `","nav":{"nav":` + nav + `,"date":"2024-03-28"}}]`))
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+	}
+}
+
+func TestGetEnrichedHoldings(t *testing.T) {
+	client := newTestClient(t, enrichedHoldingsHandler(t, map[string]string{"AFUND": "25.5", "BFUND": "100"}))
+
+	enriched, err := client.GetEnrichedHoldings(context.Background())
+	if err != nil {
+		t.Fatalf("GetEnrichedHoldings() error = %v", err)
+	}
+	if len(enriched.NAVErrors) != 0 {
+		t.Errorf("NAVErrors = %v, want none", enriched.NAVErrors)
+	}
+
+	tests := []struct {
+		code  string
+		i     int
+		folio string
+		nav   float64
+		value float64
+	}{
+		{"AFUND", 0, "1", 25.5, 255},
+		{"AFUND", 1, "2", 25.5, 102},
+		{"BFUND", 0, "3", 100, 200},
+	}
+	for _, tt := range tests {
+		holding := enriched.Data[tt.code][tt.i]
+		if holding.FolioNumber != tt.folio || holding.NAV != tt.nav || holding.CurrentValue != tt.value || holding.NAVDate != "2024-03-28" {
+			t.Errorf("Data[%s][%d] = %+v, want folio %s, NAV %v, value %v", tt.code, tt.i, holding, tt.folio, tt.nav, tt.value)
+		}
+	}
+}
+
+func TestGetEnrichedHoldingsPartialNAVFailure(t *testing.T) {
+	client := newTestClient(t, enrichedHoldingsHandler(t, map[string]string{"AFUND": "25.5"}))
+
+	enriched, err := client.GetEnrichedHoldings(context.Background())
+	if err != nil {
+		t.Fatalf("GetEnrichedHoldings() error = %v", err)
+	}
+	if got := enriched.Data["AFUND"][0].CurrentValue; got != 255 {
+		t.Errorf("AFUND CurrentValue = %v, want 255", got)
+	}
+	if got := enriched.Data["BFUND"][0]; got.NAV != 0 || got.CurrentValue != 0 || got.Units != 2 {
+		t.Errorf("BFUND holding = %+v, want units without NAV", got)
+	}
+	if len(enriched.NAVErrors) != 1 || !errors.Is(enriched.NAVErrors["BFUND"], ErrFundNotFound) {
+		t.Errorf("NAVErrors = %v, want BFUND: %v", enriched.NAVErrors, ErrFundNotFound)
+	}
+}
+
+func TestGetEnrichedHoldingsNotAuthenticated(t *testing.T) {
+	client := NewClient().(*Client)
+	if _, err := client.GetEnrichedHoldings(context.Background()); !errors.Is(err, ErrNotAuthenticated) {
+		t.Errorf("GetEnrichedHoldings() error = %v, want %v", err, ErrNotAuthenticated)
+	}
+}
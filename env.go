@@ -0,0 +1,51 @@
+package kuvera
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// Environment variables read by FromEnv.
+const (
+	EnvBaseURL   = "KUVERA_BASE_URL"
+	EnvTimeout   = "KUVERA_TIMEOUT"
+	EnvUserAgent = "KUVERA_USER_AGENT"
+)
+
+// FromEnv returns client options configured from the environment, for
+// deployments that keep their configuration there:
+//
+//	KUVERA_BASE_URL    the API base URL (WithBaseURL)
+//	KUVERA_TIMEOUT     the HTTP client timeout as a Go duration, e.g. "30s" (WithTimeout)
+//	KUVERA_USER_AGENT  the User-Agent header (WithUserAgent)
+//
+// Unset or empty variables are ignored, leaving the defaults in place. An
+// error is returned if KUVERA_TIMEOUT is not a valid, non-negative duration.
+// Options passed after the returned ones take precedence:
+//
+//	options, err := kuvera.FromEnv()
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	client := kuvera.NewClient(append(options, kuvera.WithCache(time.Minute))...)
+func FromEnv() ([]ClientOption, error) {
+	var options []ClientOption
+
+	if baseURL := strings.TrimSpace(os.Getenv(EnvBaseURL)); baseURL != "" {
+		options = append(options, WithBaseURL(baseURL))
+	}
+	if s := strings.TrimSpace(os.Getenv(EnvTimeout)); s != "" {
+		timeout, err := time.ParseDuration(s)
+		if err != nil || timeout < 0 {
+			return nil, fmt.Errorf("invalid %s %q: expected a duration such as 30s", EnvTimeout, s)
+		}
+		options = append(options, WithTimeout(timeout))
+	}
+	if userAgent := strings.TrimSpace(os.Getenv(EnvUserAgent)); userAgent != "" {
+		options = append(options, WithUserAgent(userAgent))
+	}
+
+	return options, nil
+}
@@ -0,0 +1,56 @@
+package kuvera
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFromEnv(t *testing.T) {
+	t.Setenv(EnvBaseURL, "https://staging.example.test")
+	t.Setenv(EnvTimeout, "45s")
+	t.Setenv(EnvUserAgent, "portfolio-exporter/1.0")
+
+	options, err := FromEnv()
+	if err != nil {
+		t.Fatalf("FromEnv() error = %v", err)
+	}
+	client := NewClient(options...).(*Client)
+
+	if client.baseURL != "https://staging.example.test" {
+		t.Errorf("baseURL = %q, want %q", client.baseURL, "https://staging.example.test")
+	}
+	if client.httpClient.Timeout != 45*time.Second {
+		t.Errorf("timeout = %v, want 45s", client.httpClient.Timeout)
+	}
+	if client.userAgent != "portfolio-exporter/1.0" {
+		t.Errorf("userAgent = %q, want %q", client.userAgent, "portfolio-exporter/1.0")
+	}
+}
+
+func TestFromEnvUnset(t *testing.T) {
+	for _, name := range []string{EnvBaseURL, EnvTimeout, EnvUserAgent} {
+		t.Setenv(name, "")
+	}
+
+	options, err := FromEnv()
+	if err != nil {
+		t.Fatalf("FromEnv() error = %v", err)
+	}
+	if len(options) != 0 {
+		t.Errorf("FromEnv() returned %d options, want none", len(options))
+	}
+
+	client := NewClient(options...).(*Client)
+	if client.baseURL != BaseURL || client.userAgent != DefaultUserAgent {
+		t.Errorf("client = %s, want the defaults", client)
+	}
+}
+
+func TestFromEnvInvalidTimeout(t *testing.T) {
+	for _, timeout := range []string{"30", "soon", "-5s"} {
+		t.Setenv(EnvTimeout, timeout)
+		if _, err := FromEnv(); err == nil {
+			t.Errorf("FromEnv() with %s=%q error = nil, want error", EnvTimeout, timeout)
+		}
+	}
+}
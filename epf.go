@@ -0,0 +1,47 @@
+package kuvera
+
+import (
+	"context"
+	"fmt"
+)
+
+// EPFResponse represents the response from the EPF API endpoint.
+type EPFResponse struct {
+	// Status indicates if the request was successful
+	Status string `json:"status"`
+	// Data contains the EPF account details (zero if no EPF account is linked)
+	Data EPFData `json:"data"`
+}
+
+// GetEPFDetails retrieves the details of the user's linked Employees' Provident
+// Fund account: its UAN, balance, and contributions.
+//
+// Users who have not linked an EPF account get a zero Data rather than an
+// error. The user must be authenticated (logged in) before calling this method.
+//
+// Example:
+//
+//	epf, err := client.GetEPFDetails(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	if epf.Data.UAN != "" {
+//		fmt.Printf("EPF balance ₹%.2f as of %s\n", epf.Data.CurrentValue, epf.Data.LastUpdated)
+//	}
+func (c *Client) GetEPFDetails(ctx context.Context) (*EPFResponse, error) {
+	if c.token() == "" {
+		return nil, ErrNotAuthenticated
+	}
+
+	resp, err := c.makeRequest(ctx, "GET", "/api/v3/epf.json", nil)
+	if err != nil {
+		return nil, fmt.Errorf("EPF request failed: %w", err)
+	}
+
+	var epfResp EPFResponse
+	if err := c.handleResponse(resp, &epfResp, "EPF"); err != nil {
+		return &epfResp, err
+	}
+
+	return &epfResp, nil
+}
@@ -0,0 +1,58 @@
+package kuvera
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestGetEPFDetailsNotAuthenticated(t *testing.T) {
+	client := NewClient()
+	if _, err := client.GetEPFDetails(context.Background()); !errors.Is(err, ErrNotAuthenticated) {
+		t.Errorf("GetEPFDetails() error = %v, want %v", err, ErrNotAuthenticated)
+	}
+}
+
+func TestGetEPFDetailsEmpty(t *testing.T) {
+	for _, data := range []string{`{}`, `null`, `[]`} {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"status":"success","data":` + data + `}`))
+		})
+
+		epf, err := client.GetEPFDetails(context.Background())
+		if err != nil {
+			t.Fatalf("GetEPFDetails() with data %s error = %v", data, err)
+		}
+		if epf.Data != (EPFData{}) {
+			t.Errorf("GetEPFDetails() with data %s = %+v, want zero", data, epf.Data)
+		}
+	}
+}
+
+func TestGetEPFDetails(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v3/epf.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"status":"success","data":{"uan":"100200300400","current_value":450000,
+			"total_invested":380000,"employee_contribution":200000,"employer_contribution":180000,
+			"last_updated":"2024-03-31"}}`))
+	})
+
+	epf, err := client.GetEPFDetails(context.Background())
+	if err != nil {
+		t.Fatalf("GetEPFDetails() error = %v", err)
+	}
+	want := EPFData{
+		UAN:                  "100200300400",
+		CurrentValue:         450000,
+		TotalInvested:        380000,
+		EmployeeContribution: 200000,
+		EmployerContribution: 180000,
+		LastUpdated:          "2024-03-31",
+	}
+	if epf.Data != want {
+		t.Errorf("Data = %+v, want %+v", epf.Data, want)
+	}
+}
@@ -0,0 +1,74 @@
+package kuvera
+
+import (
+	"context"
+	"fmt"
+)
+
+// EquityHolding represents a single stock or ETF held in the user's demat account.
+type EquityHolding struct {
+	// Symbol is the exchange trading symbol (e.g., "INFY")
+	Symbol string `json:"symbol"`
+	// Name is the company or ETF name
+	Name string `json:"name"`
+	// ISIN is the security's ISIN code
+	ISIN string `json:"isin"`
+	// Quantity is the number of shares held
+	Quantity float64 `json:"quantity"`
+	// AverageBuyPrice is the average price paid per share
+	AverageBuyPrice float64 `json:"average_buy_price"`
+	// CurrentPrice is the latest traded price per share
+	CurrentPrice float64 `json:"current_price"`
+	// CurrentValue is the current value of the position
+	CurrentValue float64 `json:"current_value"`
+}
+
+// EquityHoldingsResponse represents the response from the equity holdings API endpoint.
+type EquityHoldingsResponse struct {
+	// Status indicates if the request was successful
+	Status string `json:"status"`
+	// Data contains one entry per stock; empty for accounts without equities
+	Data []EquityHolding `json:"data"`
+}
+
+// GetEquityHoldings retrieves the per-stock breakdown of the user's Indian
+// equities, which PortfolioData.IndianEquities only reports in aggregate.
+//
+// Accounts with no equities get an empty Data slice rather than an error.
+// The user must be authenticated (logged in) before calling this method.
+//
+// Returns:
+//   - EquityHoldingsResponse: Contains the symbol, ISIN, quantity, and prices of each stock
+//   - error: Authentication errors, network errors, or API errors
+//
+// Example:
+//
+//	equities, err := client.GetEquityHoldings(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, s := range equities.Data {
+//		fmt.Printf("%s: %.0f @ ₹%.2f = ₹%.2f\n", s.Symbol, s.Quantity, s.CurrentPrice, s.CurrentValue)
+//	}
+func (c *Client) GetEquityHoldings(ctx context.Context) (*EquityHoldingsResponse, error) {
+	if c.token() == "" {
+		return nil, ErrNotAuthenticated
+	}
+
+	resp, err := c.makeRequest(ctx, "GET", "/api/v3/portfolio/equities.json", nil)
+	if err != nil {
+		return nil, fmt.Errorf("equity holdings request failed: %w", err)
+	}
+
+	var equitiesResp EquityHoldingsResponse
+	if err := c.handleResponse(resp, &equitiesResp, "equity holdings"); err != nil {
+		return &equitiesResp, err
+	}
+
+	// The API omits the list entirely for accounts without equities
+	if equitiesResp.Data == nil {
+		equitiesResp.Data = []EquityHolding{}
+	}
+
+	return &equitiesResp, nil
+}
@@ -0,0 +1,52 @@
+package kuvera
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestGetEquityHoldings(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v3/portfolio/equities.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"status":"success","data":[
+			{"symbol":"INFY","name":"Infosys Ltd","isin":"INE009A01021","quantity":10,"average_buy_price":1400,"current_price":1550.5,"current_value":15505},
+			{"symbol":"NIFTYBEES","name":"Nippon India ETF Nifty 50 BeES","isin":"INF204KB14I2","quantity":100,"average_buy_price":220,"current_price":265,"current_value":26500}
+		]}`))
+	})
+
+	equities, err := client.GetEquityHoldings(context.Background())
+	if err != nil {
+		t.Fatalf("GetEquityHoldings() error = %v", err)
+	}
+	if len(equities.Data) != 2 {
+		t.Fatalf("got %d holdings, want 2", len(equities.Data))
+	}
+
+	infy := equities.Data[0]
+	if infy.Symbol != "INFY" || infy.ISIN != "INE009A01021" || infy.Quantity != 10 ||
+		infy.AverageBuyPrice != 1400 || infy.CurrentPrice != 1550.5 || infy.CurrentValue != 15505 {
+		t.Errorf("unexpected holding: %+v", infy)
+	}
+	if equities.Data[1].Symbol != "NIFTYBEES" || equities.Data[1].CurrentValue != 26500 {
+		t.Errorf("unexpected holding: %+v", equities.Data[1])
+	}
+}
+
+func TestGetEquityHoldingsEmpty(t *testing.T) {
+	for _, body := range []string{`{"status":"success","data":[]}`, `{"status":"success"}`, `{"status":"success","data":null}`} {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(body))
+		})
+
+		equities, err := client.GetEquityHoldings(context.Background())
+		if err != nil {
+			t.Fatalf("GetEquityHoldings(%s) error = %v", body, err)
+		}
+		if equities.Data == nil || len(equities.Data) != 0 {
+			t.Errorf("GetEquityHoldings(%s) Data = %#v, want an empty slice", body, equities.Data)
+		}
+	}
+}
@@ -40,7 +40,7 @@ func main() {
 		return
 	}
 
-	if loginResp.Status != "success" {
+	if !loginResp.IsSuccess() {
 		fmt.Printf("❌ Login failed: %s\n", loginResp.Error)
 		fmt.Println("💡 Please check your credentials")
 		return
@@ -108,8 +108,8 @@ func main() {
 				break
 			}
 			for _, holding := range fundHoldings {
-				fmt.Printf("   💼 %s: Folio %s - ₹%.2f (%.3f units)\n",
-					fundCode, holding.FolioNumber, holding.AllottedAmount, holding.Units)
+				// Holding's String method masks the folio number
+				fmt.Printf("   💼 %s: %s\n", fundCode, holding)
 				fmt.Printf("      📂 Category: %s | Direct: %t | Orders: %d\n",
 					holding.KuveraCategory, holding.Direct, len(holding.OrderDetails))
 				if holding.IsSip && len(holding.SIPs) > 0 {
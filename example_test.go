@@ -36,7 +36,7 @@ func ExampleClient_Login() {
 		log.Fatal(err)
 	}
 
-	if resp.Status == "success" {
+	if resp.IsSuccess() {
 		fmt.Printf("Login successful! Welcome %s", resp.Name)
 	} else {
 		fmt.Printf("Login failed: %s", resp.Error)
@@ -126,6 +126,21 @@ func ExampleClient_GetGoldPrice() {
 		goldPrice.Taxes.CGST, goldPrice.Taxes.SGST)
 }
 
+// ExampleClient_GetGoldPriceWithResponse demonstrates how to inspect the HTTP
+// response of a call, which needs the concrete *kuvera.Client.
+func ExampleClient_GetGoldPriceWithResponse() {
+	client := kuvera.NewClient().(*kuvera.Client)
+	ctx := context.Background()
+
+	goldPrice, resp, err := client.GetGoldPriceWithResponse(ctx)
+	if err != nil {
+		log.Fatal("Failed to get gold price:", err)
+	}
+
+	fmt.Printf("🥇 Gold buy: ₹%.2f per gram (status %d, remaining quota %s)\n",
+		goldPrice.CurrentGoldPrice.Buy, resp.StatusCode, resp.Header.Get("X-RateLimit-Remaining"))
+}
+
 // ExampleClient_workflowExample demonstrates a complete workflow.
 func ExampleClient_workflowExample() {
 	client := kuvera.NewClient()
@@ -161,4 +176,4 @@ func ExampleClient_workflowExample() {
 	fmt.Printf("  • Overall gain: %.2f%%\n", portfolio.Data.CurrentGainPercent)
 	fmt.Printf("  • Mutual funds return: %.2f%%\n", portfolio.Data.MutualFunds.AbsolutePercentage)
 	fmt.Printf("  • Current XIRR: %.2f%%\n", portfolio.Data.CurrentXIRR)
-}
\ No newline at end of file
+}
@@ -0,0 +1,68 @@
+package kuvera
+
+import (
+	"fmt"
+	"strings"
+)
+
+// String returns a concise summary of the portfolio's value, gain and XIRR.
+func (p PortfolioData) String() string {
+	return fmt.Sprintf("Portfolio: value ₹%.2f, gain %.2f%%, XIRR %.2f%%",
+		p.CurrentValue, p.CurrentGainPercent, p.CurrentXIRR)
+}
+
+// String returns a concise summary of the holding's folio, units and amount.
+// The folio number is masked to its last four characters.
+func (h Holding) String() string {
+	return fmt.Sprintf("Folio %s (%s): %.3f units, ₹%.2f invested",
+		maskFolio(h.FolioNumber), h.KuveraCategory, h.Units, h.AllottedAmount)
+}
+
+// String returns a concise summary of the gold buy and sell prices.
+func (g GoldPriceResponse) String() string {
+	return fmt.Sprintf("Gold: buy ₹%.2f/g, sell ₹%.2f/g",
+		g.CurrentGoldPrice.Buy, g.CurrentGoldPrice.Sell)
+}
+
+// String describes the client without revealing its credentials: the access
+// token and session ID are masked to their first and last few characters, which
+// is enough to tell sessions apart in logs.
+func (c *Client) String() string {
+	token := "none"
+	if t := c.token(); t != "" {
+		token = "Bearer " + maskSecret(t)
+	}
+	return fmt.Sprintf("kuvera.Client{baseURL: %q, token: %q, sessionID: %q}",
+		c.baseURL, token, maskSecret(c.session()))
+}
+
+// GoString implements fmt.GoStringer so that %#v is redacted like String.
+func (c *Client) GoString() string {
+	return c.String()
+}
+
+// maskSecret keeps the first and last three characters of a secret and hides
+// the rest. Secrets too short to keep anything back are hidden entirely.
+func maskSecret(secret string) string {
+	const visible = 3
+
+	runes := []rune(secret)
+	if len(runes) == 0 {
+		return ""
+	}
+	if len(runes) < 4*visible {
+		return "…"
+	}
+	return string(runes[:visible]) + "…" + string(runes[len(runes)-visible:])
+}
+
+// maskFolio hides all but the last four characters of a folio number.
+func maskFolio(folio string) string {
+	const visible = 4
+
+	runes := []rune(folio)
+	if len(runes) <= visible {
+		return strings.Repeat("*", len(runes))
+	}
+	return strings.Repeat("*", len(runes)-visible) + string(runes[len(runes)-visible:])
+}
@@ -0,0 +1,236 @@
+package kuvera
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ErrFundNotFound is returned when Kuvera has no fund with the requested code.
+var ErrFundNotFound = errors.New("fund not found")
+
+// FundDetails represents the metadata of a single fund.
+type FundDetails struct {
+	// Code is the Kuvera fund code
+	Code string `json:"code"`
+	// Name is the full fund name
+	Name string `json:"name"`
+	// ShortName is the abbreviated fund name
+	ShortName string `json:"short_name"`
+	// FundHouse is the asset management company
+	FundHouse string `json:"fund_house"`
+	// Category is the fund category (e.g., "Equity")
+	Category string `json:"category"`
+	// FundCategory is the detailed SEBI category (e.g., "Flexi Cap Fund")
+	FundCategory string `json:"fund_category"`
+	// ISIN is the fund ISIN code
+	ISIN string `json:"ISIN"`
+	// ExpenseRatio is the total expense ratio in percent
+	ExpenseRatio float64 `json:"expense_ratio"`
+	// AUM is the assets under management in crores
+	AUM float64 `json:"aum"`
+	// Benchmark is the fund's benchmark index
+	Benchmark string `json:"benchmark"`
+	// NAV contains the latest Net Asset Value
+	NAV FundNAV `json:"nav"`
+	// Direct indicates if this is a direct plan ("Y" or "N")
+	Direct string `json:"direct"`
+}
+
+// FundNAV represents a fund's Net Asset Value on a date.
+type FundNAV struct {
+	// NAV is the Net Asset Value
+	NAV float64 `json:"nav"`
+	// Date is the date of the NAV
+	Date string `json:"date"`
+}
+
+// GetFundDetails retrieves the metadata for a single fund, such as the codes
+// returned by GetHoldings.
+//
+// Returns ErrInvalidFundCode without a request if the code is malformed, and
+// ErrFundNotFound if Kuvera does not know it. This endpoint does not require
+// authentication.
+//
+// Returns:
+//   - FundDetails: Contains the fund's name, category, expense ratio, AUM, and benchmark
+//   - error: Validation errors, ErrFundNotFound, network errors, or API errors
+//
+// Example:
+//
+//	fund, err := client.GetFundDetails(ctx, "PPFAS-GR")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("%s: expense ratio %.2f%%, AUM ₹%.0f Cr\n", fund.Name, fund.ExpenseRatio, fund.AUM)
+func (c *Client) GetFundDetails(ctx context.Context, fundCode string) (*FundDetails, error) {
+	if err := validateFundCode(fundCode); err != nil {
+		return nil, err
+	}
+
+	endpoint := "/mf/api/v5/fund_schemes/" + url.PathEscape(fundCode) + ".json"
+	resp, err := c.makeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("fund details request failed: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, fmt.Errorf("%w: %s", ErrFundNotFound, fundCode)
+	}
+
+	// The endpoint returns a list holding the requested scheme
+	var funds []FundDetails
+	if err := c.handleResponse(resp, &funds, "fund details"); err != nil {
+		return nil, err
+	}
+	if len(funds) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrFundNotFound, fundCode)
+	}
+
+	return &funds[0], nil
+}
+
+// Fund catalogue errors
+var (
+	ErrInvalidFundPlan = errors.New("invalid fund plan: expected direct or regular")
+	ErrInvalidPage     = errors.New("page and page size cannot be negative")
+)
+
+// FundPlan selects direct or regular plans in a FundFilter.
+type FundPlan string
+
+// Fund plans accepted by FundFilter.
+const (
+	FundPlanDirect  FundPlan = "direct"
+	FundPlanRegular FundPlan = "regular"
+)
+
+// FundFilter narrows down the funds listed by GetAvailableFunds. Zero fields
+// do not filter.
+type FundFilter struct {
+	// Category restricts the list to a fund category (e.g., "Equity")
+	Category string
+	// FundHouse restricts the list to an asset management company
+	FundHouse string
+	// Plan restricts the list to direct or regular plans
+	Plan FundPlan
+	// Page is the page to fetch, starting at 1; zero fetches the first page
+	Page int
+	// PerPage is the number of funds per page; zero uses the server default
+	PerPage int
+}
+
+// query encodes the filter as query parameters, omitting zero fields.
+func (f FundFilter) query() url.Values {
+	query := url.Values{}
+	if category := strings.TrimSpace(f.Category); category != "" {
+		query.Set("category", category)
+	}
+	if fundHouse := strings.TrimSpace(f.FundHouse); fundHouse != "" {
+		query.Set("fund_house", fundHouse)
+	}
+	if f.Plan != "" {
+		query.Set("plan", string(f.Plan))
+	}
+	if f.Page > 0 {
+		query.Set("page", strconv.Itoa(f.Page))
+	}
+	if f.PerPage > 0 {
+		query.Set("per_page", strconv.Itoa(f.PerPage))
+	}
+	return query
+}
+
+// AvailableFund is a fund open for investment.
+type AvailableFund struct {
+	// Code is the Kuvera fund code
+	Code string `json:"code"`
+	// Name is the full fund name
+	Name string `json:"name"`
+	// Category is the fund category (e.g., "Equity")
+	Category string `json:"category"`
+	// FundHouse is the asset management company
+	FundHouse string `json:"fund_house"`
+	// Direct indicates if this is a direct plan ("Y" or "N")
+	Direct string `json:"direct"`
+	// NAV is the latest Net Asset Value
+	NAV float64 `json:"nav"`
+	// MinInvestment is the minimum lump sum investment in INR
+	MinInvestment float64 `json:"min_investment"`
+	// NFO indicates the fund is a New Fund Offer still in its subscription period
+	NFO bool `json:"nfo"`
+}
+
+// FundListResponse represents one page of the fund catalogue.
+type FundListResponse struct {
+	// Status indicates if the request was successful
+	Status string `json:"status"`
+	// Data contains the funds on this page
+	Data []AvailableFund `json:"data"`
+	// Page is the number of this page, starting at 1
+	Page int `json:"page"`
+	// TotalPages is the number of pages matching the filter
+	TotalPages int `json:"total_pages"`
+}
+
+// HasNextPage reports whether more pages follow this one.
+func (r *FundListResponse) HasNextPage() bool {
+	return r.Page < r.TotalPages
+}
+
+// GetAvailableFunds retrieves a page of the funds open for investment,
+// including New Fund Offers, narrowed down by filter.
+//
+// The list is paginated: request further pages by setting filter.Page while
+// the response's HasNextPage reports true. This endpoint does not require
+// authentication.
+//
+// Example:
+//
+//	filter := kuvera.FundFilter{Category: "Equity", Plan: kuvera.FundPlanDirect}
+//	for {
+//		funds, err := client.GetAvailableFunds(ctx, filter)
+//		if err != nil {
+//			log.Fatal(err)
+//		}
+//		for _, fund := range funds.Data {
+//			fmt.Printf("%s: NAV %.2f, minimum ₹%.0f\n", fund.Name, fund.NAV, fund.MinInvestment)
+//		}
+//		if !funds.HasNextPage() {
+//			break
+//		}
+//		filter.Page = funds.Page + 1
+//	}
+func (c *Client) GetAvailableFunds(ctx context.Context, filter FundFilter) (*FundListResponse, error) {
+	if filter.Plan != "" && filter.Plan != FundPlanDirect && filter.Plan != FundPlanRegular {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidFundPlan, filter.Plan)
+	}
+	if filter.Page < 0 || filter.PerPage < 0 {
+		return nil, ErrInvalidPage
+	}
+
+	endpoint := "/mf/api/v5/fund_schemes/list.json"
+	if query := filter.query(); len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+	resp, err := c.makeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("fund list request failed: %w", err)
+	}
+
+	var fundsResp FundListResponse
+	if err := c.handleResponse(resp, &fundsResp, "fund list"); err != nil {
+		return &fundsResp, err
+	}
+
+	if fundsResp.Data == nil {
+		fundsResp.Data = []AvailableFund{}
+	}
+
+	return &fundsResp, nil
+}
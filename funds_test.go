@@ -0,0 +1,126 @@
+package kuvera
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestGetFundDetailsEmptyCode(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request expected for an empty fund code")
+	})
+
+	if _, err := client.GetFundDetails(context.Background(), "  "); !errors.Is(err, ErrEmptyFundCode) {
+		t.Errorf("GetFundDetails() error = %v, want %v", err, ErrEmptyFundCode)
+	}
+}
+
+func TestGetFundDetailsNotFound(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`<html>Not Found</html>`))
+	})
+
+	if _, err := client.GetFundDetails(context.Background(), "NOPE"); !errors.Is(err, ErrFundNotFound) {
+		t.Errorf("GetFundDetails() error = %v, want %v", err, ErrFundNotFound)
+	}
+}
+
+func TestGetFundDetails(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/mf/api/v5/fund_schemes/PPFAS-GR.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write([]byte(`[{"code":"PPFAS-GR","name":"Parag Parikh Flexi Cap Growth Direct Plan",
+			"fund_house":"PPFAS_MF","category":"Equity","fund_category":"Flexi Cap Fund",
+			"ISIN":"INF879O01027","expense_ratio":0.63,"aum":68542.3,"benchmark":"NIFTY 500 TRI",
+			"nav":{"nav":78.1234,"date":"2024-03-28"},"direct":"Y"}]`))
+	})
+
+	fund, err := client.GetFundDetails(context.Background(), "PPFAS-GR")
+	if err != nil {
+		t.Fatalf("GetFundDetails() error = %v", err)
+	}
+	if fund.Name != "Parag Parikh Flexi Cap Growth Direct Plan" || fund.Category != "Equity" {
+		t.Errorf("unexpected fund: %+v", fund)
+	}
+	if fund.ExpenseRatio != 0.63 || fund.AUM != 68542.3 || fund.Benchmark != "NIFTY 500 TRI" {
+		t.Errorf("unexpected fund figures: %+v", fund)
+	}
+	if fund.NAV.NAV != 78.1234 {
+		t.Errorf("NAV = %v, want 78.1234", fund.NAV.NAV)
+	}
+}
+
+func TestFundFilterQuery(t *testing.T) {
+	tests := []struct {
+		filter FundFilter
+		want   string
+	}{
+		{FundFilter{}, ""},
+		{FundFilter{Category: "Equity", Plan: FundPlanDirect}, "category=Equity&plan=direct"},
+		{FundFilter{FundHouse: " PPFAS Mutual Fund ", Page: 2, PerPage: 50}, "fund_house=PPFAS+Mutual+Fund&page=2&per_page=50"},
+	}
+	for _, tt := range tests {
+		if got := tt.filter.query().Encode(); got != tt.want {
+			t.Errorf("%+v query = %q, want %q", tt.filter, got, tt.want)
+		}
+	}
+}
+
+func TestGetAvailableFundsValidation(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request expected for an invalid filter")
+	})
+
+	ctx := context.Background()
+	if _, err := client.GetAvailableFunds(ctx, FundFilter{Plan: "growth"}); !errors.Is(err, ErrInvalidFundPlan) {
+		t.Errorf("GetAvailableFunds() error = %v, want %v", err, ErrInvalidFundPlan)
+	}
+	if _, err := client.GetAvailableFunds(ctx, FundFilter{Page: -1}); !errors.Is(err, ErrInvalidPage) {
+		t.Errorf("GetAvailableFunds() error = %v, want %v", err, ErrInvalidPage)
+	}
+}
+
+func TestGetAvailableFundsPaginated(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/mf/api/v5/fund_schemes/list.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		switch page := r.URL.Query().Get("page"); page {
+		case "":
+			w.Write([]byte(`{"status":"success","page":1,"total_pages":2,"data":[
+				{"code":"AFUND","name":"A Fund Direct Growth","category":"Equity","nav":25.5,"min_investment":500}]}`))
+		case "2":
+			w.Write([]byte(`{"status":"success","page":2,"total_pages":2,"data":[
+				{"code":"NFO1","name":"New Fund Direct Growth","category":"Equity","nav":10,"min_investment":1000,"nfo":true}]}`))
+		default:
+			t.Errorf("unexpected page %q", page)
+		}
+	})
+
+	var codes []string
+	filter := FundFilter{Category: "Equity"}
+	for {
+		funds, err := client.GetAvailableFunds(context.Background(), filter)
+		if err != nil {
+			t.Fatalf("GetAvailableFunds() error = %v", err)
+		}
+		for _, fund := range funds.Data {
+			codes = append(codes, fund.Code)
+		}
+		if !funds.HasNextPage() {
+			if !funds.Data[0].NFO || funds.Data[0].MinInvestment != 1000 {
+				t.Errorf("last page fund = %+v, want the NFO", funds.Data[0])
+			}
+			break
+		}
+		filter.Page = funds.Page + 1
+	}
+
+	if len(codes) != 2 || codes[0] != "AFUND" || codes[1] != "NFO1" {
+		t.Errorf("fund codes = %v, want [AFUND NFO1]", codes)
+	}
+}
@@ -0,0 +1,90 @@
+package kuvera
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// addReplaySeeds adds the saved responses in testdata/replay matching pattern
+// to the fuzz corpus, so that mutations start from real API shapes.
+func addReplaySeeds(f *testing.F, pattern string) {
+	f.Helper()
+
+	paths, err := filepath.Glob(filepath.Join("testdata", "replay", pattern))
+	if err != nil || len(paths) == 0 {
+		f.Fatalf("no seed responses match %s", pattern)
+	}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(data)
+	}
+}
+
+func FuzzHoldingsUnmarshal(f *testing.F) {
+	addReplaySeeds(f, "holdings.json")
+	f.Add([]byte(`{"F":[{"folioNumber":"1","source":"kuvera","units":1,"order_details":[{"units":0,"amount":5,"order_date":"31/12/2023"}],
+		"sips":[{"frequency":"Weekly","start_date":"0001-01-01","order_trigger_date":"9999-12-31","end_date":7,"state":"active"}]}]}`))
+	f.Add([]byte(`{"F":[{"sips":[{"frequency":"Monthly","start_date":"2024-01-31T00:00:00Z","end_date":"","state":"active"}]}],"G":null}`))
+	f.Add([]byte(`null`))
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var holdings HoldingsResponse
+		if err := json.Unmarshal(data, &holdings); err != nil {
+			return
+		}
+
+		var streamed HoldingsResponse
+		if err := streamed.decodeStream(json.NewDecoder(bytes.NewReader(data))); err != nil {
+			t.Errorf("decodeStream() error = %v for data json.Unmarshal accepts", err)
+		}
+
+		holdings.FundCodes()
+		holdings.Deduplicate()
+		holdings.UpcomingSIPs(90*24*time.Hour, from)
+		holdings.CommittedAmountByMandate()
+		for _, fundHoldings := range holdings {
+			for _, h := range fundHoldings {
+				_ = h.String()
+				h.PnL(10)
+				h.AverageNAV()
+				for _, order := range h.OrderDetails {
+					order.Date()
+				}
+				for _, sip := range h.SIPs {
+					sip.Start()
+					sip.End()
+				}
+			}
+		}
+	})
+}
+
+func FuzzPortfolioUnmarshal(f *testing.F) {
+	addReplaySeeds(f, "portfolio*.json")
+	f.Add([]byte(`{"status":"success","data":{"gold":{"xirr":"NaN","kuvera":{"xirr":"1e400"}},"fixed_deposit":{"total_invested":"  "},
+		"us_equities":[],"epf":null,"save_smarts":{"current_value":"12"}}}`))
+	f.Add([]byte(`{"data":{"fixed_deposit":{"total_invested":"1e3"},"current_value":1e308,"invested":-1e308}}`))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var portfolio PortfolioResponse
+		if err := json.Unmarshal(data, &portfolio); err != nil {
+			return
+		}
+
+		portfolio.validate(data)
+		p := portfolio.Data
+		p.Metrics()
+		p.Allocation()
+		p.Reconcile()
+		_ = p.String()
+		DiffPortfolio(&p, nil)
+	})
+}
@@ -0,0 +1,68 @@
+package kuvera
+
+import (
+	"context"
+	"fmt"
+)
+
+// Goal represents a single investment goal and its progress.
+type Goal struct {
+	// ID is the unique goal identifier
+	ID int `json:"id"`
+	// Name is the user-given name of the goal
+	Name string `json:"name"`
+	// TargetAmount is the amount the goal aims to reach
+	TargetAmount float64 `json:"target_amount"`
+	// TargetDate is the date by which the goal should be reached
+	TargetDate string `json:"target_date"`
+	// CurrentValue is the current value of investments linked to the goal
+	// (zero for goals without linked investments)
+	CurrentValue float64 `json:"current_value"`
+	// OnTrack indicates if the goal is on track to meet its target
+	OnTrack bool `json:"on_track"`
+}
+
+// GoalsResponse represents the response from the goals API endpoint.
+type GoalsResponse struct {
+	// Status indicates if the request was successful
+	Status string `json:"status"`
+	// Data contains all goals, including those without linked investments
+	Data []Goal `json:"data"`
+}
+
+// GetGoals retrieves the user's investment goals with their current progress.
+//
+// Goals that have no linked investments are still returned, with a zero
+// CurrentValue. The user must be authenticated (logged in) before calling this method.
+//
+// Returns:
+//   - GoalsResponse: Contains every goal with target and current values
+//   - error: Authentication errors, network errors, or API errors
+//
+// Example:
+//
+//	goals, err := client.GetGoals(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, goal := range goals.Data {
+//		fmt.Printf("%s: ₹%.2f of ₹%.2f (on track: %t)\n",
+//			goal.Name, goal.CurrentValue, goal.TargetAmount, goal.OnTrack)
+//	}
+func (c *Client) GetGoals(ctx context.Context) (*GoalsResponse, error) {
+	if c.token() == "" {
+		return nil, ErrNotAuthenticated
+	}
+
+	resp, err := c.makeRequest(ctx, "GET", "/api/v3/goals.json", nil)
+	if err != nil {
+		return nil, fmt.Errorf("goals request failed: %w", err)
+	}
+
+	var goalsResp GoalsResponse
+	if err := c.handleResponse(resp, &goalsResp, "goals"); err != nil {
+		return &goalsResp, err
+	}
+
+	return &goalsResp, nil
+}
@@ -0,0 +1,52 @@
+package kuvera
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestGetGoals(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v3/goals.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer test-token" {
+			t.Errorf("unexpected Authorization header: %q", got)
+		}
+		w.Write([]byte(`{"status":"success","data":[
+			{"id":1,"name":"Retirement","target_amount":5000000,"target_date":"2045-04-01","current_value":1250000.5,"on_track":true},
+			{"id":2,"name":"House","target_amount":2000000,"target_date":"2030-01-01","current_value":null,"on_track":false},
+			{"id":3,"name":"Vacation","target_amount":150000,"target_date":"2026-12-01","on_track":false}
+		]}`))
+	})
+
+	goals, err := client.GetGoals(context.Background())
+	if err != nil {
+		t.Fatalf("GetGoals() error = %v", err)
+	}
+	if len(goals.Data) != 3 {
+		t.Fatalf("got %d goals, want 3", len(goals.Data))
+	}
+
+	funded := goals.Data[0]
+	if funded.Name != "Retirement" || funded.CurrentValue != 1250000.5 || !funded.OnTrack {
+		t.Errorf("unexpected funded goal: %+v", funded)
+	}
+	for _, unfunded := range goals.Data[1:] {
+		if unfunded.CurrentValue != 0 {
+			t.Errorf("goal %q CurrentValue = %v, want 0", unfunded.Name, unfunded.CurrentValue)
+		}
+		if unfunded.TargetAmount == 0 || unfunded.TargetDate == "" {
+			t.Errorf("goal %q lost its target: %+v", unfunded.Name, unfunded)
+		}
+	}
+}
+
+func TestGetGoalsNotAuthenticated(t *testing.T) {
+	client := NewClient().(*Client)
+	if _, err := client.GetGoals(context.Background()); !errors.Is(err, ErrNotAuthenticated) {
+		t.Errorf("GetGoals() error = %v, want %v", err, ErrNotAuthenticated)
+	}
+}
@@ -0,0 +1,125 @@
+package kuvera
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Transaction errors
+var (
+	ErrTransactionsDisabled = errors.New("transactions are disabled: construct the client with WithTransactionsEnabled")
+	ErrInvalidAmount        = errors.New("amount must be positive")
+)
+
+// WithTransactionsEnabled allows methods that move money, such as BuyGold and
+// SellGold. Without it those methods return ErrTransactionsDisabled, so a
+// read-only integration can never place an order by accident.
+func WithTransactionsEnabled() ClientOption {
+	return func(c *clientConfig) {
+		c.transactionsEnabled = true
+	}
+}
+
+// GoldOrderResponse represents the response from the gold order API endpoints.
+type GoldOrderResponse struct {
+	// Status indicates if the request was successful
+	Status string `json:"status"`
+	// OrderID is the unique order identifier
+	OrderID string `json:"order_id"`
+	// Price is the executed price per gram
+	Price float64 `json:"price"`
+	// Quantity is the quantity of gold in grams
+	Quantity float64 `json:"quantity"`
+	// Amount is the total order amount including taxes
+	Amount float64 `json:"amount"`
+	// OrderStatus is the state of the order (e.g., "completed", "pending")
+	OrderStatus string `json:"order_status"`
+}
+
+// goldOrderRequest is the request payload for gold orders.
+type goldOrderRequest struct {
+	Amount   float64 `json:"amount,omitempty"`
+	Quantity float64 `json:"quantity,omitempty"`
+}
+
+// validate checks that the order is for exactly one of a positive amount or a
+// positive quantity.
+func (o goldOrderRequest) validate() error {
+	switch {
+	case o.Amount < 0 || o.Quantity < 0:
+		return fmt.Errorf("%w: amount %v, quantity %v", ErrInvalidAmount, o.Amount, o.Quantity)
+	case (o.Amount > 0) == (o.Quantity > 0):
+		return fmt.Errorf("%w: exactly one of amount and quantity must be set", ErrInvalidAmount)
+	}
+	return nil
+}
+
+// BuyGold buys gold worth the given amount in INR.
+//
+// This moves money: the client must be constructed with WithTransactionsEnabled,
+// otherwise ErrTransactionsDisabled is returned. The user must be authenticated
+// (logged in) before calling this method.
+//
+// Returns:
+//   - GoldOrderResponse: Contains the order ID, executed price, quantity, and status
+//   - error: Safeguard, validation, authentication, network, or API errors
+//
+// Example:
+//
+//	client := kuvera.NewClient(kuvera.WithTransactionsEnabled())
+//	// ... login ...
+//	order, err := client.BuyGold(ctx, 1000)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Bought %.4fg at ₹%.2f/g\n", order.Quantity, order.Price)
+func (c *Client) BuyGold(ctx context.Context, amount float64) (*GoldOrderResponse, error) {
+	if !c.transactionsEnabled {
+		return nil, ErrTransactionsDisabled
+	}
+	order := goldOrderRequest{Amount: amount}
+	if err := order.validate(); err != nil {
+		return nil, err
+	}
+	if c.token() == "" {
+		return nil, ErrNotAuthenticated
+	}
+
+	return c.placeGoldOrder(ctx, "/api/v3/gold/buy.json", order, "gold buy")
+}
+
+// SellGold sells the given quantity of gold in grams.
+//
+// This moves money: the client must be constructed with WithTransactionsEnabled,
+// otherwise ErrTransactionsDisabled is returned. The user must be authenticated
+// (logged in) before calling this method.
+func (c *Client) SellGold(ctx context.Context, grams float64) (*GoldOrderResponse, error) {
+	if !c.transactionsEnabled {
+		return nil, ErrTransactionsDisabled
+	}
+	order := goldOrderRequest{Quantity: grams}
+	if err := order.validate(); err != nil {
+		return nil, err
+	}
+	if c.token() == "" {
+		return nil, ErrNotAuthenticated
+	}
+
+	return c.placeGoldOrder(ctx, "/api/v3/gold/sell.json", order, "gold sell")
+}
+
+// placeGoldOrder submits a gold order to endpoint.
+func (c *Client) placeGoldOrder(ctx context.Context, endpoint string, order goldOrderRequest, operation string) (*GoldOrderResponse, error) {
+	resp, err := c.makeRequest(ctx, "POST", endpoint, order)
+	if err != nil {
+		return nil, fmt.Errorf("%s request failed: %w", operation, err)
+	}
+
+	var orderResp GoldOrderResponse
+	if err := c.handleResponse(resp, &orderResp, operation); err != nil {
+		return &orderResp, err
+	}
+
+	return &orderResp, nil
+}
@@ -0,0 +1,82 @@
+package kuvera
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"testing"
+)
+
+func TestGoldOrdersDisabledByDefault(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request expected while transactions are disabled")
+	})
+
+	if _, err := client.BuyGold(context.Background(), 1000); !errors.Is(err, ErrTransactionsDisabled) {
+		t.Errorf("BuyGold() error = %v, want %v", err, ErrTransactionsDisabled)
+	}
+	if _, err := client.SellGold(context.Background(), 0.5); !errors.Is(err, ErrTransactionsDisabled) {
+		t.Errorf("SellGold() error = %v, want %v", err, ErrTransactionsDisabled)
+	}
+}
+
+func TestGoldOrdersInvalidAmount(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request expected for an invalid amount")
+	}, WithTransactionsEnabled())
+
+	if _, err := client.BuyGold(context.Background(), 0); !errors.Is(err, ErrInvalidAmount) {
+		t.Errorf("BuyGold() error = %v, want %v", err, ErrInvalidAmount)
+	}
+	if _, err := client.SellGold(context.Background(), -1); !errors.Is(err, ErrInvalidAmount) {
+		t.Errorf("SellGold() error = %v, want %v", err, ErrInvalidAmount)
+	}
+}
+
+func TestGoldOrderRequestValidate(t *testing.T) {
+	tests := []struct {
+		order goldOrderRequest
+		valid bool
+	}{
+		{goldOrderRequest{Amount: 1000}, true},
+		{goldOrderRequest{Quantity: 0.5}, true},
+		{goldOrderRequest{}, false},
+		{goldOrderRequest{Amount: -1000}, false},
+		{goldOrderRequest{Quantity: -0.5}, false},
+		{goldOrderRequest{Amount: 1000, Quantity: 0.5}, false},
+		{goldOrderRequest{Amount: math.NaN()}, false},
+	}
+
+	for _, tt := range tests {
+		err := tt.order.validate()
+		if tt.valid && err != nil {
+			t.Errorf("validate(%+v) error = %v, want nil", tt.order, err)
+		}
+		if !tt.valid && !errors.Is(err, ErrInvalidAmount) {
+			t.Errorf("validate(%+v) error = %v, want %v", tt.order, err, ErrInvalidAmount)
+		}
+	}
+}
+
+func TestBuyGold(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/v3/gold/buy.json" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var req goldOrderRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Amount != 1000 {
+			t.Errorf("unexpected order payload: %+v (error: %v)", req, err)
+		}
+		w.Write([]byte(`{"status":"success","order_id":"GLD123","price":6150.25,"quantity":0.1578,"amount":1000,"order_status":"completed"}`))
+	}, WithTransactionsEnabled())
+
+	order, err := client.BuyGold(context.Background(), 1000)
+	if err != nil {
+		t.Fatalf("BuyGold() error = %v", err)
+	}
+	if order.OrderID != "GLD123" || order.Price != 6150.25 || order.Quantity != 0.1578 || order.OrderStatus != "completed" {
+		t.Errorf("unexpected order: %+v", order)
+	}
+}
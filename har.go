@@ -0,0 +1,277 @@
+package kuvera
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+)
+
+// harRedactedHeaders are the headers whose values are replaced in HAR
+// archives, since they carry credentials.
+var harRedactedHeaders = map[string]bool{
+	"Authorization": true,
+	"Cookie":        true,
+	"Set-Cookie":    true,
+	"X-Session-Id":  true,
+}
+
+// WithHARRecorder records every request the client sends, with its response,
+// and writes them to w as a HAR 1.2 archive when the client is closed, for
+// loading into the network panel of browser devtools.
+//
+// Each entry has the method, URL, headers, status, timings and body sizes, but
+// not the bodies themselves. The values of the Authorization, Cookie,
+// Set-Cookie and X-Session-ID headers are redacted. Requests that fail without a response are
+// recorded with status 0 and the error in a custom _error field. Response body
+// sizes are known once the body is closed, which every method does. Responses
+// served by WithCache never reach the network and are not recorded.
+//
+// The first Close writes the archive; later calls write nothing, and requests
+// made after it are not recorded, so w always holds a single valid document.
+// Clients created by Clone record into the same archive, but closing a clone
+// does not write it. The recorder wraps the transport of a client set with WithHTTPClient
+// regardless of option order; that client is copied rather than modified.
+func WithHARRecorder(w io.Writer) ClientOption {
+	return func(c *clientConfig) {
+		c.harRecorder = &harRecorder{w: w}
+	}
+}
+
+// harRecorder collects HAR entries and writes them out once, on flush.
+type harRecorder struct {
+	w       io.Writer
+	mu      sync.Mutex
+	entries []*harEntry
+	written bool
+}
+
+// HAR 1.2 document structure, as specified at
+// http://www.softwareishard.com/blog/har-12-spec/.
+type (
+	harDocument struct {
+		Log harLog `json:"log"`
+	}
+	harLog struct {
+		Version string      `json:"version"`
+		Creator harCreator  `json:"creator"`
+		Entries []*harEntry `json:"entries"`
+	}
+	harCreator struct {
+		Name    string `json:"name"`
+		Version string `json:"version"`
+	}
+	harEntry struct {
+		StartedDateTime string      `json:"startedDateTime"`
+		Time            float64     `json:"time"`
+		Request         harRequest  `json:"request"`
+		Response        harResponse `json:"response"`
+		Cache           struct{}    `json:"cache"`
+		Timings         harTimings  `json:"timings"`
+		Error           string      `json:"_error,omitempty"`
+	}
+	harRequest struct {
+		Method      string         `json:"method"`
+		URL         string         `json:"url"`
+		HTTPVersion string         `json:"httpVersion"`
+		Cookies     []harNameValue `json:"cookies"`
+		Headers     []harNameValue `json:"headers"`
+		QueryString []harNameValue `json:"queryString"`
+		HeadersSize int64          `json:"headersSize"`
+		BodySize    int64          `json:"bodySize"`
+	}
+	harResponse struct {
+		Status      int            `json:"status"`
+		StatusText  string         `json:"statusText"`
+		HTTPVersion string         `json:"httpVersion"`
+		Cookies     []harNameValue `json:"cookies"`
+		Headers     []harNameValue `json:"headers"`
+		Content     harContent     `json:"content"`
+		RedirectURL string         `json:"redirectURL"`
+		HeadersSize int64          `json:"headersSize"`
+		BodySize    int64          `json:"bodySize"`
+	}
+	harContent struct {
+		Size     int64  `json:"size"`
+		MimeType string `json:"mimeType"`
+	}
+	harNameValue struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	}
+	harTimings struct {
+		Send    float64 `json:"send"`
+		Wait    float64 `json:"wait"`
+		Receive float64 `json:"receive"`
+	}
+)
+
+// applyHARRecorder replaces the configured HTTP client with a copy whose
+// transport records requests to the configured recorder.
+func (c *clientConfig) applyHARRecorder() {
+	transport := c.httpClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	httpClient := *c.httpClient
+	httpClient.Transport = &harTransport{next: transport, recorder: c.harRecorder, clock: c.clock}
+	c.httpClient = &httpClient
+}
+
+// harTransport is a RoundTripper recording each round trip as a HAR entry.
+type harTransport struct {
+	next     http.RoundTripper
+	recorder *harRecorder
+	clock    clock
+}
+
+func (t *harTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := t.clock.Now()
+	resp, err := t.next.RoundTrip(req)
+	wait := t.clock.Now().Sub(start)
+
+	entry := &harEntry{
+		StartedDateTime: start.Format(time.RFC3339Nano),
+		Time:            milliseconds(wait),
+		Request: harRequest{
+			Method:      req.Method,
+			URL:         req.URL.String(),
+			HTTPVersion: req.Proto,
+			Cookies:     []harNameValue{},
+			Headers:     harHeaders(req.Header),
+			QueryString: []harNameValue{},
+			HeadersSize: -1,
+			BodySize:    req.ContentLength,
+		},
+		Response: harResponse{
+			Cookies:     []harNameValue{},
+			Headers:     []harNameValue{},
+			HeadersSize: -1,
+			BodySize:    -1,
+			Content:     harContent{Size: -1},
+		},
+		Timings: harTimings{Wait: milliseconds(wait), Receive: -1},
+	}
+	query := req.URL.Query()
+	for _, name := range slices.Sorted(maps.Keys(query)) {
+		for _, value := range query[name] {
+			entry.Request.QueryString = append(entry.Request.QueryString, harNameValue{Name: name, Value: value})
+		}
+	}
+	if err != nil {
+		entry.Error = err.Error()
+		t.recorder.add(entry)
+		return nil, err
+	}
+
+	entry.Response.Status = resp.StatusCode
+	entry.Response.StatusText = http.StatusText(resp.StatusCode)
+	entry.Response.HTTPVersion = resp.Proto
+	entry.Response.Headers = harHeaders(resp.Header)
+	entry.Response.RedirectURL = resp.Header.Get("Location")
+	entry.Response.Content.MimeType = resp.Header.Get("Content-Type")
+	t.recorder.add(entry)
+
+	resp.Body = &harBody{ReadCloser: resp.Body, transport: t, entry: entry, start: start, headers: wait}
+	return resp, nil
+}
+
+// harBody completes a HAR entry with the body size and timing once closed.
+type harBody struct {
+	io.ReadCloser
+	transport *harTransport
+	entry     *harEntry
+	start     time.Time
+	headers   time.Duration
+	size      int64
+	closed    bool
+}
+
+func (b *harBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.size += int64(n)
+	return n, err
+}
+
+func (b *harBody) Close() error {
+	err := b.ReadCloser.Close()
+	if !b.closed {
+		b.closed = true
+		total := b.transport.clock.Now().Sub(b.start)
+		b.transport.recorder.update(func() {
+			b.entry.Time = milliseconds(total)
+			b.entry.Timings.Receive = milliseconds(total - b.headers)
+			b.entry.Response.BodySize = b.size
+			b.entry.Response.Content.Size = b.size
+		})
+	}
+	return err
+}
+
+// add records entry, unless the archive has already been written.
+func (r *harRecorder) add(entry *harEntry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.written {
+		r.entries = append(r.entries, entry)
+	}
+}
+
+// update runs f, which modifies a recorded entry, under the recorder's lock.
+func (r *harRecorder) update(f func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	f()
+}
+
+// flush writes the recorded entries as a HAR document. Only the first call
+// writes anything.
+func (r *harRecorder) flush() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.written {
+		return nil
+	}
+
+	doc := harDocument{Log: harLog{
+		Version: "1.2",
+		Creator: harCreator{Name: "github.com/adjaecent/unofficial-kuvera-api"},
+		Entries: r.entries,
+	}}
+	if doc.Log.Entries == nil {
+		doc.Log.Entries = []*harEntry{}
+	}
+	encoder := json.NewEncoder(r.w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return fmt.Errorf("writing HAR archive: %w", err)
+	}
+	r.written = true
+	r.entries = nil
+	return nil
+}
+
+// harHeaders converts headers to HAR name/value pairs sorted by name,
+// redacting credentials.
+func harHeaders(header http.Header) []harNameValue {
+	pairs := make([]harNameValue, 0, len(header))
+	for _, name := range slices.Sorted(maps.Keys(header)) {
+		for _, value := range header[name] {
+			if harRedactedHeaders[http.CanonicalHeaderKey(name)] {
+				value = "REDACTED"
+			}
+			pairs = append(pairs, harNameValue{Name: name, Value: value})
+		}
+	}
+	return pairs
+}
+
+// milliseconds returns d in fractional milliseconds, the unit of HAR timings.
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
@@ -0,0 +1,159 @@
+package kuvera
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"testing"
+)
+
+func TestWithHARRecorder(t *testing.T) {
+	var buf bytes.Buffer
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success"}`))
+	}, WithHARRecorder(&buf))
+
+	if _, err := client.GetGoldPrice(context.Background()); err != nil {
+		t.Fatalf("GetGoldPrice() error = %v", err)
+	}
+	if err := client.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	var har struct {
+		Log struct {
+			Version string `json:"version"`
+			Creator struct {
+				Name string `json:"name"`
+			} `json:"creator"`
+			Entries []struct {
+				StartedDateTime string  `json:"startedDateTime"`
+				Time            float64 `json:"time"`
+				Request         struct {
+					Method      string         `json:"method"`
+					URL         string         `json:"url"`
+					Headers     []harNameValue `json:"headers"`
+					QueryString []harNameValue `json:"queryString"`
+				} `json:"request"`
+				Response struct {
+					Status   int   `json:"status"`
+					BodySize int64 `json:"bodySize"`
+					Content  struct {
+						Size     int64  `json:"size"`
+						MimeType string `json:"mimeType"`
+					} `json:"content"`
+				} `json:"response"`
+				Timings map[string]float64 `json:"timings"`
+			} `json:"entries"`
+		} `json:"log"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &har); err != nil {
+		t.Fatalf("HAR is not valid JSON: %v\n%s", err, buf.String())
+	}
+
+	if har.Log.Version != "1.2" || har.Log.Creator.Name == "" {
+		t.Errorf("log version, creator = %q, %q, want 1.2 and a creator", har.Log.Version, har.Log.Creator.Name)
+	}
+	if len(har.Log.Entries) != 1 {
+		t.Fatalf("entries = %d, want 1", len(har.Log.Entries))
+	}
+	entry := har.Log.Entries[0]
+	if entry.StartedDateTime == "" || entry.Time < 0 {
+		t.Errorf("startedDateTime, time = %q, %v", entry.StartedDateTime, entry.Time)
+	}
+	if entry.Request.Method != "GET" || entry.Request.URL != client.baseURL+"/api/v3/gold/current_price.json?v="+DefaultAPIVersion+"&cached=true" {
+		t.Errorf("request = %s %s", entry.Request.Method, entry.Request.URL)
+	}
+	if len(entry.Request.QueryString) != 2 {
+		t.Errorf("queryString = %v, want v and cached", entry.Request.QueryString)
+	}
+	var sawAuth bool
+	for _, header := range entry.Request.Headers {
+		if header.Name == "Authorization" {
+			sawAuth = true
+			if header.Value != "REDACTED" {
+				t.Errorf("Authorization = %q, want it redacted", header.Value)
+			}
+		}
+	}
+	if !sawAuth {
+		t.Error("Authorization header missing from the request headers")
+	}
+	if entry.Response.Status != 200 || entry.Response.BodySize != 20 || entry.Response.Content.Size != 20 ||
+		entry.Response.Content.MimeType != "application/json" {
+		t.Errorf("response = %+v", entry.Response)
+	}
+	for _, timing := range []string{"send", "wait", "receive"} {
+		if _, ok := entry.Timings[timing]; !ok {
+			t.Errorf("timings missing %q: %v", timing, entry.Timings)
+		}
+	}
+
+	// The archive is written once, so a second Close leaves w a valid document
+	buf.Reset()
+	if _, err := client.GetGoldPrice(context.Background()); err != nil {
+		t.Fatalf("GetGoldPrice() error = %v", err)
+	}
+	if err := client.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("second Close() wrote %s, want nothing", buf.String())
+	}
+}
+
+func TestHARRecorderClone(t *testing.T) {
+	var buf bytes.Buffer
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":"success"}`))
+	}, WithHARRecorder(&buf))
+
+	clone := client.Clone().(*Client)
+	if _, err := clone.GetGoldPrice(context.Background()); err != nil {
+		t.Fatalf("GetGoldPrice() error = %v", err)
+	}
+	if err := clone.Close(); err != nil {
+		t.Fatalf("clone Close() error = %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Fatalf("clone Close() wrote %s, want nothing", buf.String())
+	}
+
+	if _, err := client.GetGoldPrice(context.Background()); err != nil {
+		t.Fatalf("GetGoldPrice() error = %v", err)
+	}
+	if err := client.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	var har harDocument
+	if err := json.Unmarshal(buf.Bytes(), &har); err != nil {
+		t.Fatalf("HAR is not valid JSON: %v\n%s", err, buf.String())
+	}
+	if len(har.Log.Entries) != 2 {
+		t.Errorf("entries = %d, want the clone's and the client's request", len(har.Log.Entries))
+	}
+}
+
+func TestHARHeadersRedactedAndSorted(t *testing.T) {
+	header := http.Header{
+		"X-Session-Id":  {"sess-123"},
+		"Authorization": {"Bearer test-token"},
+		"Accept":        {"application/json"},
+		"Cookie":        {"session=abc"},
+	}
+
+	want := []harNameValue{
+		{Name: "Accept", Value: "application/json"},
+		{Name: "Authorization", Value: "REDACTED"},
+		{Name: "Cookie", Value: "REDACTED"},
+		{Name: "X-Session-Id", Value: "REDACTED"},
+	}
+	for i := 0; i < 10; i++ {
+		if got := harHeaders(header); !slices.Equal(got, want) {
+			t.Fatalf("harHeaders() = %v, want %v", got, want)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package kuvera
+
+import (
+	"math/rand/v2"
+	"net/http"
+)
+
+// jitterAcceptLanguages are the Accept-Language values WithHeaderJitter picks
+// from, as sent by common browsers in English-speaking Indian locales.
+var jitterAcceptLanguages = []string{
+	"en-US,en;q=0.5",
+	"en-US,en;q=0.9",
+	"en-IN,en;q=0.9",
+	"en-IN,en-GB;q=0.9,en-US;q=0.8,en;q=0.7",
+	"en-GB,en;q=0.9,en-US;q=0.8",
+	"en-US,en;q=0.9,hi;q=0.8",
+}
+
+// jitterOptionalHeaders are the groups of headers WithHeaderJitter may omit,
+// each with the probability of omitting it. Browsers send the Sec-Fetch-*
+// headers together, and privacy extensions and older browsers drop them.
+var jitterOptionalHeaders = []struct {
+	names       []string
+	omitPercent int
+}{
+	{[]string{"Sec-Fetch-Dest", "Sec-Fetch-Mode", "Sec-Fetch-Site"}, 20},
+	{[]string{"Cache-Control", "Pragma"}, 35},
+}
+
+// WithHeaderJitter varies the non-essential browser headers from request to
+// request, so that traffic does not present one static header set: the
+// Accept-Language is picked from a pool of realistic values, and the Sec-Fetch-*
+// and cache headers are occasionally left out, as some browsers do. It may help
+// clients that get throttled despite WithUserAgentRotation.
+//
+// Headers that affect correctness, such as Accept, Content-Type, Origin,
+// Referer and Authorization, are never varied. It has no effect with
+// WithMinimalHeaders, which sends no browser headers at all.
+func WithHeaderJitter() ClientOption {
+	return func(c *clientConfig) {
+		c.headerJitter = true
+	}
+}
+
+// jitterHeaders varies the non-essential browser headers in header.
+func jitterHeaders(header http.Header) {
+	header.Set("Accept-Language", jitterAcceptLanguages[rand.IntN(len(jitterAcceptLanguages))])
+	for _, group := range jitterOptionalHeaders {
+		if rand.IntN(100) < group.omitPercent {
+			for _, name := range group.names {
+				header.Del(name)
+			}
+		}
+	}
+}
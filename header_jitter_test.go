@@ -0,0 +1,80 @@
+package kuvera
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"testing"
+)
+
+func TestWithHeaderJitter(t *testing.T) {
+	const requests = 200
+
+	var (
+		languages = make(map[string]bool)
+		omitted   = make(map[string]int)
+	)
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		language := r.Header.Get("Accept-Language")
+		if !slices.Contains(jitterAcceptLanguages, language) {
+			t.Errorf("Accept-Language = %q, not in the pool", language)
+		}
+		languages[language] = true
+
+		for _, group := range jitterOptionalHeaders {
+			present := 0
+			for _, name := range group.names {
+				if r.Header.Get(name) != "" {
+					present++
+				}
+			}
+			switch present {
+			case 0:
+				omitted[group.names[0]]++
+			case len(group.names):
+			default:
+				t.Errorf("only %d of %v sent, want all or none", present, group.names)
+			}
+		}
+
+		for name, want := range map[string]string{
+			"Accept":        "application/json, text/plain, */*",
+			"Origin":        "https://kuvera.in",
+			"Referer":       "https://kuvera.in/",
+			"Authorization": "Bearer test-token",
+		} {
+			if got := r.Header.Get(name); got != want {
+				t.Errorf("%s = %q, want %q", name, got, want)
+			}
+		}
+		w.Write([]byte(`{}`))
+	}, WithHeaderJitter())
+
+	for range requests {
+		if err := client.Ping(context.Background()); err != nil {
+			t.Fatalf("Ping() error = %v", err)
+		}
+	}
+
+	if len(languages) < 2 {
+		t.Errorf("Accept-Language took %d distinct values over %d requests, want variation", len(languages), requests)
+	}
+	for _, group := range jitterOptionalHeaders {
+		if n := omitted[group.names[0]]; n == 0 || n == requests {
+			t.Errorf("%v omitted in %d of %d requests, want some but not all", group.names, n, requests)
+		}
+	}
+}
+
+func TestWithHeaderJitterMinimalHeaders(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Accept-Language"); got != "" {
+			t.Errorf("Accept-Language = %q, want none with minimal headers", got)
+		}
+		w.Write([]byte(`{}`))
+	}, WithHeaderJitter(), WithMinimalHeaders())
+
+	if err := client.Ping(context.Background()); err != nil {
+		t.Fatalf("Ping() error = %v", err)
+	}
+}
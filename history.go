@@ -0,0 +1,137 @@
+package kuvera
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Portfolio history errors
+var (
+	ErrInvalidDateRange   = errors.New("invalid date range: from must not be after to")
+	ErrInvalidGranularity = errors.New("invalid granularity: expected daily, weekly or monthly")
+)
+
+// Granularities accepted by GetPortfolioHistory.
+const (
+	GranularityDaily   = "daily"
+	GranularityWeekly  = "weekly"
+	GranularityMonthly = "monthly"
+)
+
+// historyMaxDays is the longest range, in days, that the history endpoint
+// serves in a single request.
+const historyMaxDays = 365
+
+// PortfolioHistoryPoint is the portfolio's value on one date.
+type PortfolioHistoryPoint struct {
+	// Date is the date of the point (YYYY-MM-DD)
+	Date string `json:"date"`
+	// Value is the portfolio's current value on the date
+	Value float64 `json:"value"`
+	// Invested is the total amount invested as of the date
+	Invested float64 `json:"invested"`
+}
+
+// PortfolioHistoryResponse represents the response from the portfolio history API endpoint.
+type PortfolioHistoryResponse struct {
+	// Status indicates if the request was successful
+	Status string `json:"status"`
+	// Data contains the history points, sorted by date
+	Data []PortfolioHistoryPoint `json:"data"`
+}
+
+// GetPortfolioHistory retrieves the portfolio's value over time, for charting
+// its growth.
+//
+// The granularity is one of GranularityDaily, GranularityWeekly or
+// GranularityMonthly. The range is inclusive; from must not be after to, and
+// to must not be in the future. Kuvera serves at most a year of history per
+// request, so longer ranges are fetched a year at a time and stitched
+// together. The points are sorted by date, with one point per date. The user must be authenticated
+// (logged in) before calling this method.
+//
+// Example:
+//
+//	to := time.Now()
+//	history, err := client.GetPortfolioHistory(ctx, to.AddDate(-3, 0, 0), to, kuvera.GranularityMonthly)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, point := range history.Data {
+//		fmt.Printf("%s: ₹%.2f\n", point.Date, point.Value)
+//	}
+func (c *Client) GetPortfolioHistory(ctx context.Context, from, to time.Time, granularity string) (*PortfolioHistoryResponse, error) {
+	granularity = strings.ToLower(strings.TrimSpace(granularity))
+	if granularity != GranularityDaily && granularity != GranularityWeekly && granularity != GranularityMonthly {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidGranularity, granularity)
+	}
+	from, to = calendarDate(from), calendarDate(to)
+	if from.After(to) {
+		return nil, fmt.Errorf("%w: %s to %s", ErrInvalidDateRange, from.Format(dateLayout), to.Format(dateLayout))
+	}
+	if to.After(c.clock.Now()) {
+		return nil, fmt.Errorf("%w: %s", ErrFutureDate, to.Format(dateLayout))
+	}
+	if c.token() == "" {
+		return nil, ErrNotAuthenticated
+	}
+
+	history := &PortfolioHistoryResponse{Data: make([]PortfolioHistoryPoint, 0)}
+	for start := from; !start.After(to); {
+		end := start.AddDate(0, 0, historyMaxDays-1)
+		if end.After(to) {
+			end = to
+		}
+
+		chunk, err := c.getPortfolioHistoryChunk(ctx, start, end, granularity)
+		if err != nil {
+			return history, err
+		}
+		history.Status = chunk.Status
+		history.Data = append(history.Data, chunk.Data...)
+
+		start = end.AddDate(0, 0, 1)
+	}
+
+	sort.SliceStable(history.Data, func(i, j int) bool {
+		return history.Data[i].Date < history.Data[j].Date
+	})
+
+	// A weekly or monthly point spanning a chunk boundary may be returned by
+	// both chunks; keep the one from the later chunk, which covers more of
+	// the period
+	deduped := history.Data[:0]
+	for _, point := range history.Data {
+		if n := len(deduped); n > 0 && deduped[n-1].Date == point.Date {
+			deduped[n-1] = point
+			continue
+		}
+		deduped = append(deduped, point)
+	}
+	history.Data = deduped
+	return history, nil
+}
+
+// getPortfolioHistoryChunk fetches the history for a range no longer than historyMaxDays.
+func (c *Client) getPortfolioHistoryChunk(ctx context.Context, from, to time.Time, granularity string) (*PortfolioHistoryResponse, error) {
+	query := url.Values{
+		"from":        {from.Format(dateLayout)},
+		"to":          {to.Format(dateLayout)},
+		"granularity": {granularity},
+	}
+	resp, err := c.makeRequest(ctx, "GET", "/api/v5/portfolio/history.json?"+query.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("portfolio history request failed: %w", err)
+	}
+
+	var historyResp PortfolioHistoryResponse
+	if err := c.handleResponse(resp, &historyResp, "portfolio history"); err != nil {
+		return nil, err
+	}
+	return &historyResp, nil
+}
@@ -0,0 +1,117 @@
+package kuvera
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestGetPortfolioHistoryValidation(t *testing.T) {
+	clk := newFakeClock()
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request expected for invalid arguments")
+	}, withClock(clk))
+
+	ctx := context.Background()
+	from := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2023, 5, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		from, to    time.Time
+		granularity string
+		want        error
+	}{
+		{"from after to", from, to, GranularityDaily, ErrInvalidDateRange},
+		{"future end", from, clk.Now().AddDate(0, 0, 2), GranularityDaily, ErrFutureDate},
+		{"unknown granularity", to, from, "hourly", ErrInvalidGranularity},
+		{"empty granularity", to, from, "", ErrInvalidGranularity},
+	}
+	for _, tt := range tests {
+		if _, err := client.GetPortfolioHistory(ctx, tt.from, tt.to, tt.granularity); !errors.Is(err, tt.want) {
+			t.Errorf("%s: GetPortfolioHistory() error = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestGetPortfolioHistoryChunked(t *testing.T) {
+	type chunk struct{ from, to string }
+	var chunks []chunk
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v5/portfolio/history.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		query := r.URL.Query()
+		if got := query.Get("granularity"); got != GranularityMonthly {
+			t.Errorf("granularity = %q, want %q", got, GranularityMonthly)
+		}
+		chunks = append(chunks, chunk{query.Get("from"), query.Get("to")})
+
+		// Answer with the chunk's last and first day, out of order
+		json.NewEncoder(w).Encode(PortfolioHistoryResponse{
+			Status: "success",
+			Data: []PortfolioHistoryPoint{
+				{Date: query.Get("to"), Value: 2, Invested: 1},
+				{Date: query.Get("from"), Value: 1, Invested: 1},
+			},
+		})
+	}, withClock(newFakeClock()))
+
+	from := time.Date(2021, 1, 1, 15, 0, 0, 0, time.UTC)
+	to := time.Date(2023, 6, 30, 0, 0, 0, 0, time.UTC)
+	history, err := client.GetPortfolioHistory(context.Background(), from, to, "Monthly")
+	if err != nil {
+		t.Fatalf("GetPortfolioHistory() error = %v", err)
+	}
+
+	wantChunks := []chunk{
+		{"2021-01-01", "2021-12-31"},
+		{"2022-01-01", "2022-12-31"},
+		{"2023-01-01", "2023-06-30"},
+	}
+	if len(chunks) != len(wantChunks) {
+		t.Fatalf("requested chunks %v, want %v", chunks, wantChunks)
+	}
+	for i := range wantChunks {
+		if chunks[i] != wantChunks[i] {
+			t.Errorf("chunk %d = %v, want %v", i, chunks[i], wantChunks[i])
+		}
+	}
+
+	wantDates := []string{"2021-01-01", "2021-12-31", "2022-01-01", "2022-12-31", "2023-01-01", "2023-06-30"}
+	if len(history.Data) != len(wantDates) {
+		t.Fatalf("history = %+v, want %d points", history.Data, len(wantDates))
+	}
+	for i, date := range wantDates {
+		if history.Data[i].Date != date {
+			t.Errorf("point %d date = %s, want %s", i, history.Data[i].Date, date)
+		}
+	}
+}
+
+func TestGetPortfolioHistoryChunkBoundaryDuplicate(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		// Both chunks return the month spanning their boundary
+		points := []PortfolioHistoryPoint{{Date: "2021-12-01", Value: 1}, {Date: "2022-01-01", Value: 2}}
+		if r.URL.Query().Get("from") != "2021-01-01" {
+			points = []PortfolioHistoryPoint{{Date: "2022-01-01", Value: 3}, {Date: "2022-02-01", Value: 4}}
+		}
+		json.NewEncoder(w).Encode(PortfolioHistoryResponse{Status: "success", Data: points})
+	}, withClock(newFakeClock()))
+
+	from := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	history, err := client.GetPortfolioHistory(context.Background(), from, to, GranularityMonthly)
+	if err != nil {
+		t.Fatalf("GetPortfolioHistory() error = %v", err)
+	}
+
+	want := []PortfolioHistoryPoint{{Date: "2021-12-01", Value: 1}, {Date: "2022-01-01", Value: 3}, {Date: "2022-02-01", Value: 4}}
+	if !reflect.DeepEqual(history.Data, want) {
+		t.Errorf("history = %+v, want %+v", history.Data, want)
+	}
+}
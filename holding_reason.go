@@ -0,0 +1,80 @@
+package kuvera
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// HoldingReason is the free-form reason Kuvera attaches to a holding, usually
+// to explain why it is flagged invalid.
+//
+// Kuvera sends it as null, a string, or an object such as
+// {"code":"KYC_PENDING","message":"KYC pending"}, so it is kept as the raw JSON
+// and marshals back unchanged. Use Text, or Holding.ReasonText, to read it.
+type HoldingReason struct {
+	raw json.RawMessage
+}
+
+// reasonTextKeys are the object keys checked, in order, for a reason's text.
+var reasonTextKeys = []string{"message", "reason", "description", "text", "error"}
+
+// UnmarshalJSON accepts any JSON value, so that an unexpected reason never
+// fails decoding of the holdings.
+func (r *HoldingReason) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		*r = HoldingReason{}
+		return nil
+	}
+	r.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON returns the reason as it was received, or null if there is none.
+func (r HoldingReason) MarshalJSON() ([]byte, error) {
+	if len(r.raw) == 0 {
+		return []byte("null"), nil
+	}
+	return r.raw, nil
+}
+
+// Text returns the reason as human-readable text.
+//
+// A string reason is returned trimmed. For an object, the first non-empty
+// string among its "message", "reason", "description", "text" and "error"
+// fields is returned, falling back to the compact JSON of the object. Any other
+// value is returned as JSON, and a missing or null reason as "".
+func (r HoldingReason) Text() string {
+	if len(r.raw) == 0 {
+		return ""
+	}
+
+	var text string
+	if err := json.Unmarshal(r.raw, &text); err == nil {
+		return strings.TrimSpace(text)
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(r.raw, &fields); err == nil {
+		for _, key := range reasonTextKeys {
+			if err := json.Unmarshal(fields[key], &text); err == nil {
+				if text = strings.TrimSpace(text); text != "" {
+					return text
+				}
+			}
+		}
+	}
+
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, r.raw); err != nil {
+		return string(r.raw)
+	}
+	return compact.String()
+}
+
+// ReasonText returns the holding's reason as human-readable text, or "" if
+// there is none. See HoldingReason.Text.
+func (h Holding) ReasonText() string {
+	return h.Reason.Text()
+}
@@ -0,0 +1,61 @@
+package kuvera
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestHoldingReasonText(t *testing.T) {
+	tests := []struct {
+		name   string
+		reason string
+		want   string
+	}{
+		{"null", `null`, ""},
+		{"string", `" KYC pending "`, "KYC pending"},
+		{"empty string", `""`, ""},
+		{"object with message", `{"code":"KYC_PENDING","message":"KYC pending"}`, "KYC pending"},
+		{"object with reason", `{"reason":"Folio mismatch","message":""}`, "Folio mismatch"},
+		{"object without text", `{"code": 42}`, `{"code":42}`},
+		{"number", `7`, "7"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := `{"AFUND":[{"reason":` + tt.reason + `,"valid_flag":"N","source":"imported"}]}`
+			var holdings HoldingsResponse
+			if err := json.Unmarshal([]byte(body), &holdings); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+
+			holding := holdings["AFUND"][0]
+			if got := holding.ReasonText(); got != tt.want {
+				t.Errorf("ReasonText() = %q, want %q", got, tt.want)
+			}
+			if holding.ValidFlag != ValidFlagInvalid || holding.Source != HoldingSourceImported {
+				t.Errorf("ValidFlag, Source = %q, %q, want %q, %q", holding.ValidFlag, holding.Source, ValidFlagInvalid, HoldingSourceImported)
+			}
+		})
+	}
+}
+
+func TestHoldingReasonRoundTrip(t *testing.T) {
+	for _, reason := range []string{`null`, `"KYC pending"`, `{"code":"KYC_PENDING"}`} {
+		var holding Holding
+		if err := json.Unmarshal([]byte(`{"reason":`+reason+`}`), &holding); err != nil {
+			t.Fatalf("json.Unmarshal(%s) error = %v", reason, err)
+		}
+		got, err := json.Marshal(holding.Reason)
+		if err != nil {
+			t.Fatalf("json.Marshal() error = %v", err)
+		}
+		if string(got) != reason {
+			t.Errorf("json.Marshal() = %s, want %s", got, reason)
+		}
+	}
+
+	var holding Holding
+	if got, _ := json.Marshal(holding.Reason); string(got) != "null" {
+		t.Errorf("json.Marshal() of zero reason = %s, want null", got)
+	}
+}
@@ -0,0 +1,227 @@
+package kuvera
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ErrFundNotHeld is returned by GetHoldingsForFund when the user holds no units of the fund.
+var ErrFundNotHeld = errors.New("fund not held")
+
+// FundCodes returns the codes of all funds in the holdings, sorted
+// lexicographically. It returns an empty, non-nil slice for empty holdings.
+func (h HoldingsResponse) FundCodes() []string {
+	codes := make([]string, 0, len(h))
+	for code := range h {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// FundHolding pairs a holding with the code of its fund.
+type FundHolding struct {
+	// FundCode is the code of the fund the holding belongs to
+	FundCode string
+	// Holding is the holding itself
+	Holding Holding
+}
+
+// SortedByAmount flattens the holdings into a list sorted by allotted amount,
+// ascending or, if desc is true, descending, for example to show the largest
+// holdings first.
+//
+// Holdings with equal amounts are ordered by fund code and then folio number,
+// both ascending, so the order is deterministic. It returns an empty, non-nil
+// slice for empty holdings.
+func (h HoldingsResponse) SortedByAmount(desc bool) []FundHolding {
+	sorted := make([]FundHolding, 0, len(h))
+	for _, code := range h.FundCodes() {
+		for _, holding := range h[code] {
+			sorted = append(sorted, FundHolding{FundCode: code, Holding: holding})
+		}
+	}
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if a.Holding.AllottedAmount != b.Holding.AllottedAmount {
+			if desc {
+				return a.Holding.AllottedAmount > b.Holding.AllottedAmount
+			}
+			return a.Holding.AllottedAmount < b.Holding.AllottedAmount
+		}
+		if a.FundCode != b.FundCode {
+			return a.FundCode < b.FundCode
+		}
+		return a.Holding.FolioNumber < b.Holding.FolioNumber
+	})
+	return sorted
+}
+
+// GetHoldingsForFund retrieves the holdings of a single fund, one per folio.
+//
+// It fetches all holdings with GetHoldings and returns ErrFundNotHeld if the
+// fund code is not among them. The fund code must be valid (see ValidFundCode).
+// The user must be authenticated (logged in) before calling this method.
+//
+// Example:
+//
+//	holdings, err := client.GetHoldingsForFund(ctx, "INF179K01BE2")
+//	if errors.Is(err, kuvera.ErrFundNotHeld) {
+//		fmt.Println("Not invested in this fund")
+//	} else if err != nil {
+//		log.Fatal(err)
+//	}
+func (c *Client) GetHoldingsForFund(ctx context.Context, fundCode string) ([]Holding, error) {
+	if err := validateFundCode(fundCode); err != nil {
+		return nil, err
+	}
+
+	holdings, err := c.GetHoldings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fundHoldings, ok := (*holdings)[fundCode]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrFundNotHeld, fundCode)
+	}
+	return fundHoldings, nil
+}
+
+// HoldingPnL is the profit or loss of a holding at a given NAV.
+type HoldingPnL struct {
+	// Units is the number of units bought, summed from the order details
+	Units float64
+	// CostBasis is the amount invested, summed from the order details
+	CostBasis float64
+	// AverageCost is the unit-weighted cost per unit; zero without units
+	AverageCost float64
+	// CurrentValue is Units multiplied by the current NAV
+	CurrentValue float64
+	// AbsoluteReturn is CurrentValue minus CostBasis
+	AbsoluteReturn float64
+	// ReturnPercent is AbsoluteReturn relative to CostBasis; zero when the cost basis is zero
+	ReturnPercent float64
+}
+
+// TotalCost returns the amount invested in the holding, summed from its order details.
+func (h Holding) TotalCost() float64 {
+	var cost float64
+	for _, order := range h.OrderDetails {
+		cost += order.Amount
+	}
+	return cost
+}
+
+// AverageNAV returns the unit-weighted average NAV at which the holding was
+// bought: its total cost divided by the units in its order details. It returns
+// zero for a holding without units.
+func (h Holding) AverageNAV() float64 {
+	var units float64
+	for _, order := range h.OrderDetails {
+		units += order.Units
+	}
+	if units == 0 {
+		return 0
+	}
+	return h.TotalCost() / units
+}
+
+// PnL computes the profit or loss of the holding at currentNAV, using its
+// order details as the cost basis.
+func (h Holding) PnL(currentNAV float64) HoldingPnL {
+	var pnl HoldingPnL
+	for _, order := range h.OrderDetails {
+		pnl.Units += order.Units
+		pnl.CostBasis += order.Amount
+	}
+
+	if pnl.Units != 0 {
+		pnl.AverageCost = pnl.CostBasis / pnl.Units
+	}
+	pnl.CurrentValue = pnl.Units * currentNAV
+	pnl.AbsoluteReturn = pnl.CurrentValue - pnl.CostBasis
+	if pnl.CostBasis != 0 {
+		pnl.ReturnPercent = pnl.AbsoluteReturn / pnl.CostBasis * 100
+	}
+	return pnl
+}
+
+// HoldingSource is where a holding was recorded from.
+type HoldingSource string
+
+// Known holding sources.
+const (
+	// HoldingSourceKuvera marks holdings bought through Kuvera
+	HoldingSourceKuvera HoldingSource = "kuvera"
+	// HoldingSourceImported marks holdings imported from a CAS statement
+	HoldingSourceImported HoldingSource = "imported"
+)
+
+// Deduplicate merges holdings of the same fund and folio that Kuvera reports
+// once per source, typically once as "kuvera" and once as "imported", so that
+// each folio appears once.
+//
+// Merged holdings sum their units, lock-free units and allotted amounts and
+// concatenate their order details. Their remaining fields are taken from the
+// most authoritative source, "kuvera" first, whose order details also come
+// first. Holdings are only merged across different sources: two holdings of the
+// same folio from the same source, and holdings without a folio number, are
+// genuinely separate and kept as they are. The receiver is not modified.
+func (h HoldingsResponse) Deduplicate() HoldingsResponse {
+	deduped := make(HoldingsResponse, len(h))
+	for fundCode, holdings := range h {
+		var (
+			merged  []Holding
+			sources []map[HoldingSource]bool
+		)
+	next:
+		for _, holding := range holdings {
+			folio := strings.TrimSpace(holding.FolioNumber)
+			source := HoldingSource(strings.ToLower(strings.TrimSpace(string(holding.Source))))
+			if folio != "" {
+				for i := range merged {
+					if strings.TrimSpace(merged[i].FolioNumber) == folio && !sources[i][source] {
+						merged[i] = mergeHoldings(merged[i], holding)
+						sources[i][source] = true
+						continue next
+					}
+				}
+			}
+			holding.OrderDetails = append([]OrderDetail(nil), holding.OrderDetails...)
+			merged = append(merged, holding)
+			sources = append(sources, map[HoldingSource]bool{source: true})
+		}
+		deduped[fundCode] = merged
+	}
+	return deduped
+}
+
+// mergeHoldings combines two holdings of the same folio, keeping the fields of
+// the more authoritative one.
+func mergeHoldings(a, b Holding) Holding {
+	if sourceRank(b.Source) < sourceRank(a.Source) {
+		a, b = b, a
+	}
+	a.Units += b.Units
+	a.LockFreeUnits += b.LockFreeUnits
+	a.AllottedAmount += b.AllottedAmount
+	a.OrderDetails = append(append([]OrderDetail(nil), a.OrderDetails...), b.OrderDetails...)
+	return a
+}
+
+// sourceRank orders holding sources by authority, lowest first.
+func sourceRank(source HoldingSource) int {
+	switch HoldingSource(strings.ToLower(strings.TrimSpace(string(source)))) {
+	case HoldingSourceKuvera:
+		return 0
+	case HoldingSourceImported:
+		return 1
+	default:
+		return 2
+	}
+}
@@ -0,0 +1,210 @@
+package kuvera
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestHoldingsResponseFundCodes(t *testing.T) {
+	holdings := HoldingsResponse{
+		"UTINI-GR":  {{}},
+		"AXIS-BLUE": {{}},
+		"PPFAS-GR":  {{}},
+		"HDFC-MID":  {{}},
+	}
+
+	want := []string{"AXIS-BLUE", "HDFC-MID", "PPFAS-GR", "UTINI-GR"}
+	if got := holdings.FundCodes(); !reflect.DeepEqual(got, want) {
+		t.Errorf("FundCodes() = %v, want %v", got, want)
+	}
+}
+
+func TestHoldingsResponseFundCodesEmpty(t *testing.T) {
+	for _, holdings := range []HoldingsResponse{{}, nil} {
+		codes := holdings.FundCodes()
+		if codes == nil || len(codes) != 0 {
+			t.Errorf("FundCodes() = %#v, want empty non-nil slice", codes)
+		}
+	}
+}
+
+func TestGetHoldingsForFund(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{
+			"AXIS-BLUE":[{"folioNumber":"111","units":10},{"folioNumber":"222","units":5}],
+			"HDFC-MID":[{"folioNumber":"333","units":20}]
+		}`))
+	})
+
+	holdings, err := client.GetHoldingsForFund(context.Background(), "AXIS-BLUE")
+	if err != nil {
+		t.Fatalf("GetHoldingsForFund() error = %v", err)
+	}
+	if len(holdings) != 2 || holdings[0].FolioNumber != "111" || holdings[1].FolioNumber != "222" {
+		t.Errorf("unexpected holdings: %+v", holdings)
+	}
+
+	if _, err := client.GetHoldingsForFund(context.Background(), "PPFAS-GR"); !errors.Is(err, ErrFundNotHeld) {
+		t.Errorf("GetHoldingsForFund() error = %v, want %v", err, ErrFundNotHeld)
+	}
+}
+
+func TestGetHoldingsForFundEmptyCode(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request expected for an empty fund code")
+	})
+
+	if _, err := client.GetHoldingsForFund(context.Background(), ""); !errors.Is(err, ErrEmptyFundCode) {
+		t.Errorf("GetHoldingsForFund() error = %v, want %v", err, ErrEmptyFundCode)
+	}
+}
+
+func TestHoldingPnL(t *testing.T) {
+	holding := Holding{
+		OrderDetails: []OrderDetail{
+			{Amount: 1000, NAV: 10, Units: 100},
+			{Amount: 3000, NAV: 20, Units: 150},
+			{Amount: 1000, NAV: 40, Units: 25},
+		},
+	}
+
+	pnl := holding.PnL(25)
+
+	want := HoldingPnL{
+		Units:          275,
+		CostBasis:      5000,
+		AverageCost:    5000.0 / 275,
+		CurrentValue:   6875,
+		AbsoluteReturn: 1875,
+		ReturnPercent:  37.5,
+	}
+	if pnl != want {
+		t.Errorf("PnL() = %+v, want %+v", pnl, want)
+	}
+}
+
+func TestHoldingPnLZeroCostBasis(t *testing.T) {
+	for _, holding := range []Holding{{}, {OrderDetails: []OrderDetail{{Units: 10}}}} {
+		pnl := holding.PnL(50)
+		if pnl.ReturnPercent != 0 || math.IsNaN(pnl.AverageCost) || math.IsInf(pnl.ReturnPercent, 0) {
+			t.Errorf("PnL() = %+v, want no percentage for a zero cost basis", pnl)
+		}
+	}
+}
+
+func TestHoldingsResponseDeduplicate(t *testing.T) {
+	var holdings HoldingsResponse
+	err := json.Unmarshal([]byte(`{"AXIS-BLUE": [
+		{"folioNumber": "9100/42", "source": "imported", "units": 40, "lock_free_units": 40, "allottedAmount": 2000,
+		 "order_details": [{"units": 40, "amount": 2000, "order_date": "2020-01-10"}]},
+		{"folioNumber": "9100/42", "source": "kuvera", "units": 60, "lock_free_units": 10, "allottedAmount": 3500,
+		 "isSip": true, "valid_flag": "Y", "order_details": [{"units": 60, "amount": 3500, "order_date": "2023-06-05"}]}
+	]}`), &holdings)
+	if err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+
+	deduped := holdings.Deduplicate()
+
+	if len(deduped["AXIS-BLUE"]) != 1 {
+		t.Fatalf("Deduplicate() = %+v, want a single merged folio", deduped["AXIS-BLUE"])
+	}
+	h := deduped["AXIS-BLUE"][0]
+	if h.Units != 100 || h.LockFreeUnits != 50 || h.AllottedAmount != 5500 {
+		t.Errorf("merged units/lock-free/allotted = %v/%v/%v, want 100/50/5500", h.Units, h.LockFreeUnits, h.AllottedAmount)
+	}
+	if h.Source != HoldingSourceKuvera || !h.IsSip || h.ValidFlag != ValidFlagValid {
+		t.Errorf("merged holding = %+v, want the kuvera holding's fields", h)
+	}
+	var dates []string
+	for _, order := range h.OrderDetails {
+		dates = append(dates, order.OrderDate)
+	}
+	if want := []string{"2023-06-05", "2020-01-10"}; !reflect.DeepEqual(dates, want) {
+		t.Errorf("order dates = %v, want %v", dates, want)
+	}
+	if len(holdings["AXIS-BLUE"]) != 2 || len(holdings["AXIS-BLUE"][1].OrderDetails) != 1 {
+		t.Error("Deduplicate() modified the receiver")
+	}
+}
+
+func TestHoldingsResponseDeduplicateDistinctFolios(t *testing.T) {
+	var holdings HoldingsResponse
+	err := json.Unmarshal([]byte(`{"AXIS-BLUE": [
+		{"folioNumber": "9100/42", "source": "kuvera", "units": 10},
+		{"folioNumber": "9100/42", "source": "kuvera", "units": 20},
+		{"folioNumber": "7788/01", "source": "imported", "units": 30},
+		{"folioNumber": "", "source": "imported", "units": 40},
+		{"folioNumber": "", "source": "kuvera", "units": 50}
+	]}`), &holdings)
+	if err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+
+	deduped := holdings.Deduplicate()
+
+	if !reflect.DeepEqual(deduped, holdings) {
+		t.Errorf("Deduplicate() = %+v, want distinct folios left unmerged", deduped)
+	}
+}
+
+func TestHoldingAverageNAV(t *testing.T) {
+	tests := []struct {
+		name       string
+		orders     []OrderDetail
+		totalCost  float64
+		averageNAV float64
+	}{
+		{"multiple orders", []OrderDetail{
+			{Amount: 1000, NAV: 10, Units: 100},
+			{Amount: 3000, NAV: 20, Units: 150},
+			{Amount: 1000, NAV: 40, Units: 25},
+		}, 5000, 5000.0 / 275},
+		{"single order", []OrderDetail{{Amount: 2500, NAV: 25, Units: 100}}, 2500, 25},
+		{"no orders", nil, 0, 0},
+		{"zero units", []OrderDetail{{Amount: 500}, {Amount: 250}}, 750, 0},
+	}
+
+	for _, tt := range tests {
+		holding := Holding{OrderDetails: tt.orders}
+		if got := holding.TotalCost(); got != tt.totalCost {
+			t.Errorf("%s: TotalCost() = %v, want %v", tt.name, got, tt.totalCost)
+		}
+		if got := holding.AverageNAV(); got != tt.averageNAV {
+			t.Errorf("%s: AverageNAV() = %v, want %v", tt.name, got, tt.averageNAV)
+		}
+	}
+}
+
+func TestHoldingsResponseSortedByAmount(t *testing.T) {
+	holdings := HoldingsResponse{
+		"MID":   {{FolioNumber: "F2", AllottedAmount: 5000}, {FolioNumber: "F1", AllottedAmount: 5000}},
+		"BLUE":  {{FolioNumber: "F9", AllottedAmount: 5000}},
+		"LARGE": {{FolioNumber: "F3", AllottedAmount: 20000}},
+		"SMALL": {{FolioNumber: "F4", AllottedAmount: 1000}},
+	}
+
+	label := func(sorted []FundHolding) []string {
+		labels := make([]string, len(sorted))
+		for i, fh := range sorted {
+			labels[i] = fh.FundCode + "/" + fh.Holding.FolioNumber
+		}
+		return labels
+	}
+
+	if got, want := label(holdings.SortedByAmount(true)), []string{"LARGE/F3", "BLUE/F9", "MID/F1", "MID/F2", "SMALL/F4"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SortedByAmount(true) = %v, want %v", got, want)
+	}
+	if got, want := label(holdings.SortedByAmount(false)), []string{"SMALL/F4", "BLUE/F9", "MID/F1", "MID/F2", "LARGE/F3"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SortedByAmount(false) = %v, want %v", got, want)
+	}
+
+	if got := (HoldingsResponse{}).SortedByAmount(true); got == nil || len(got) != 0 {
+		t.Errorf("SortedByAmount() on empty holdings = %#v, want empty non-nil slice", got)
+	}
+}
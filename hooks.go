@@ -0,0 +1,43 @@
+package kuvera
+
+import (
+	"errors"
+	"net/http"
+)
+
+// ErrRequestAborted is returned when a WithBeforeRequest hook rejects a request.
+var ErrRequestAborted = errors.New("request aborted by hook")
+
+// WithBeforeRequest registers a hook that can modify each request just before
+// it is sent, for example to sign it or to add headers fetched from a secrets
+// service.
+//
+// The hook runs after all default headers, including Authorization, have been
+// set, and again for every retry attempt. Responses served from the cache do
+// not invoke it. If the hook returns an error, the request is not sent and the
+// error is returned wrapped in ErrRequestAborted; such requests are not retried.
+func WithBeforeRequest(fn func(*http.Request) error) ClientOption {
+	return func(c *clientConfig) {
+		c.beforeRequest = fn
+	}
+}
+
+// WithResponseTransform registers a function that rewrites each response body
+// before it is decoded, as an escape hatch for malformed JSON from the API, such
+// as a NaN where a number is expected.
+//
+// The transform receives the raw body of every response, successful or not,
+// including responses served from the cache, and must return valid JSON.
+// Streamed responses are buffered in full so that the transform sees the whole
+// body.
+//
+// Example:
+//
+//	client := kuvera.NewClient(kuvera.WithResponseTransform(func(body []byte) []byte {
+//		return bytes.ReplaceAll(body, []byte(":NaN"), []byte(":null"))
+//	}))
+func WithResponseTransform(fn func(body []byte) []byte) ClientOption {
+	return func(c *clientConfig) {
+		c.responseTransform = fn
+	}
+}
@@ -0,0 +1,72 @@
+package kuvera
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func sign(secret, method, path string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(method + " " + path))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestWithBeforeRequest(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.Header.Get("X-Signature"), sign("s3cret", r.Method, r.URL.Path); got != want {
+			t.Errorf("X-Signature = %q, want %q", got, want)
+		}
+		w.Write([]byte(`{"status":"success"}`))
+	}, WithBeforeRequest(func(req *http.Request) error {
+		if req.Header.Get("Authorization") != "Bearer test-token" {
+			t.Errorf("Authorization = %q when the hook runs", req.Header.Get("Authorization"))
+		}
+		req.Header.Set("X-Signature", sign("s3cret", req.Method, req.URL.Path))
+		return nil
+	}))
+
+	if _, err := client.GetGoals(context.Background()); err != nil {
+		t.Fatalf("GetGoals() error = %v", err)
+	}
+}
+
+func TestWithBeforeRequestAbort(t *testing.T) {
+	errNoKey := errors.New("signing key unavailable")
+	calls := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request expected when the hook fails")
+	}, WithRetry(3, 0), WithBeforeRequest(func(req *http.Request) error {
+		calls++
+		return errNoKey
+	}))
+
+	_, err := client.GetGoals(context.Background())
+	if !errors.Is(err, ErrRequestAborted) || !errors.Is(err, errNoKey) {
+		t.Errorf("GetGoals() error = %v, want %v wrapping %v", err, ErrRequestAborted, errNoKey)
+	}
+	if calls != 1 {
+		t.Errorf("hook called %d times, want 1 (aborted requests are not retried)", calls)
+	}
+}
+
+func TestWithResponseTransform(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"current_gold_price":{"buy":6250.5,"sell":NaN}}`))
+	}, WithResponseTransform(func(body []byte) []byte {
+		return bytes.ReplaceAll(body, []byte("NaN"), []byte("null"))
+	}))
+
+	price, err := client.GetGoldPrice(context.Background())
+	if err != nil {
+		t.Fatalf("GetGoldPrice() error = %v", err)
+	}
+	if price.CurrentGoldPrice.Buy != 6250.5 || price.CurrentGoldPrice.Sell != 0 {
+		t.Errorf("CurrentGoldPrice = %+v, want buy 6250.5 and no sell price", price.CurrentGoldPrice)
+	}
+}
@@ -0,0 +1,102 @@
+package kuvera
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Identifier errors
+var (
+	ErrInvalidFundCode = errors.New("invalid fund code")
+	ErrInvalidISIN     = errors.New("invalid ISIN")
+)
+
+// maxFundCodeLength is the longest fund code ValidFundCode accepts.
+const maxFundCodeLength = 64
+
+// ValidFundCode reports whether s is well-formed as a Kuvera fund code, such as
+// "PPFAS-GR" or an ISIN like "INF879O01027": up to 64 ASCII letters, digits,
+// hyphens and underscores. It does not check that the fund exists.
+func ValidFundCode(s string) bool {
+	if s == "" || len(s) > maxFundCodeLength {
+		return false
+	}
+	for _, r := range s {
+		if !isASCIIAlphanumeric(r) && r != '-' && r != '_' {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidISIN reports whether s is a valid International Securities
+// Identification Number: a two-letter country code, nine alphanumeric
+// characters and a check digit, which must match the Luhn checksum of the
+// digits obtained by converting each letter to a number (A=10 ... Z=35).
+func ValidISIN(s string) bool {
+	if !isISINShaped(s) {
+		return false
+	}
+
+	var digits []byte
+	for _, r := range s {
+		if r >= 'A' && r <= 'Z' {
+			digits = fmt.Appendf(digits, "%d", r-'A'+10)
+		} else {
+			digits = append(digits, byte(r))
+		}
+	}
+
+	// Luhn checksum: double every second digit from the right
+	sum := 0
+	for i := range digits {
+		d := int(digits[len(digits)-1-i] - '0')
+		if i%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+// isISINShaped reports whether s has the form of an ISIN, regardless of its check digit.
+func isISINShaped(s string) bool {
+	if len(s) != 12 {
+		return false
+	}
+	for i, r := range s {
+		switch {
+		case i < 2 && (r < 'A' || r > 'Z'):
+			return false
+		case i == 11 && (r < '0' || r > '9'):
+			return false
+		case !(r >= 'A' && r <= 'Z') && !(r >= '0' && r <= '9'):
+			return false
+		}
+	}
+	return true
+}
+
+// isASCIIAlphanumeric reports whether r is an ASCII letter or digit.
+func isASCIIAlphanumeric(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+}
+
+// validateFundCode checks a fund code before it is sent to the API, so that a
+// typo fails fast instead of with an opaque 404. Codes shaped like an ISIN must
+// also carry a valid check digit.
+func validateFundCode(fundCode string) error {
+	switch {
+	case strings.TrimSpace(fundCode) == "":
+		return ErrEmptyFundCode
+	case !ValidFundCode(fundCode):
+		return fmt.Errorf("%w: %q", ErrInvalidFundCode, fundCode)
+	case isISINShaped(fundCode) && !ValidISIN(fundCode):
+		return fmt.Errorf("%w: %q", ErrInvalidISIN, fundCode)
+	}
+	return nil
+}
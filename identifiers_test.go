@@ -0,0 +1,94 @@
+package kuvera
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestValidFundCode(t *testing.T) {
+	tests := []struct {
+		code string
+		want bool
+	}{
+		{"PPFAS-GR", true},
+		{"INF179K01BE2", true},
+		{"axis_blue", true},
+		{"", false},
+		{"../holdings", false},
+		{"HDFC MID", false},
+		{"CODE?x=1", false},
+		{"FÜND", false},
+		{string(make([]byte, maxFundCodeLength+1)), false},
+	}
+	for _, tt := range tests {
+		if got := ValidFundCode(tt.code); got != tt.want {
+			t.Errorf("ValidFundCode(%q) = %v, want %v", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestValidISIN(t *testing.T) {
+	tests := []struct {
+		isin string
+		want bool
+	}{
+		{"INF179K01BE2", true},
+		{"INF879O01027", true},
+		{"INE002A01018", true},
+		{"US0378331005", true},
+		{"US0378331006", false}, // wrong check digit
+		{"INF209K01YY2", false},
+		{"inf179k01be2", false},
+		{"INF179K01BE", false},
+		{"1NF179K01BE2", false},
+		{"INF179K01BEX", false},
+		{"PPFAS-GR", false},
+	}
+	for _, tt := range tests {
+		if got := ValidISIN(tt.isin); got != tt.want {
+			t.Errorf("ValidISIN(%q) = %v, want %v", tt.isin, got, tt.want)
+		}
+	}
+}
+
+func TestFundCodeValidatedBeforeRequest(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("no request expected, got %s %s", r.Method, r.URL.Path)
+	}, WithTransactionsEnabled())
+	ctx := context.Background()
+
+	tests := []struct {
+		code string
+		want error
+	}{
+		{" ", ErrEmptyFundCode},
+		{"../holdings", ErrInvalidFundCode},
+		{"INF209K01YY2", ErrInvalidISIN},
+	}
+	for _, tt := range tests {
+		if _, err := client.GetFundDetails(ctx, tt.code); !errors.Is(err, tt.want) {
+			t.Errorf("GetFundDetails(%q) error = %v, want %v", tt.code, err, tt.want)
+		}
+		if _, err := client.GetHoldingsForFund(ctx, tt.code); !errors.Is(err, tt.want) {
+			t.Errorf("GetHoldingsForFund(%q) error = %v, want %v", tt.code, err, tt.want)
+		}
+		if err := client.AddToWatchlist(ctx, tt.code); !errors.Is(err, tt.want) {
+			t.Errorf("AddToWatchlist(%q) error = %v, want %v", tt.code, err, tt.want)
+		}
+		if err := client.RemoveFromWatchlist(ctx, tt.code); !errors.Is(err, tt.want) {
+			t.Errorf("RemoveFromWatchlist(%q) error = %v, want %v", tt.code, err, tt.want)
+		}
+		req := SIPCreateRequest{FundCode: tt.code, Amount: 1000, Frequency: SIPFrequencyMonthly}
+		if _, err := client.CreateSIP(ctx, req); !errors.Is(err, tt.want) {
+			t.Errorf("CreateSIP(%q) error = %v, want %v", tt.code, err, tt.want)
+		}
+	}
+
+	date := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	if _, err := client.GetNAVs(ctx, []string{"../holdings"}, date); !errors.Is(err, ErrInvalidFundCode) {
+		t.Errorf("GetNAVs() error = %v, want %v", err, ErrInvalidFundCode)
+	}
+}
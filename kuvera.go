@@ -35,6 +35,12 @@
 //
 // All methods return detailed error information. Network errors, JSON parsing
 // errors, and API errors are wrapped with descriptive messages.
+//
+// # Contexts
+//
+// Every method takes a context for cancellation and deadlines. A nil context is
+// treated as context.Background() rather than causing a panic, but it cannot be
+// cancelled, so prefer passing the caller's context.
 package kuvera
 
 import (
@@ -44,25 +50,37 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
+	"math"
 	"net/http"
+	"net/http/cookiejar"
 	"net/url"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
 // BaseURL is the base URL for the Kuvera API.
 const (
-	BaseURL = "https://api.kuvera.in"
-	DefaultTimeout = 30 * time.Second
+	BaseURL          = "https://api.kuvera.in"
+	DefaultTimeout   = 30 * time.Second
 	DefaultUserAgent = "Mozilla/5.0 (Macintosh; Intel Mac OS X 10.15; rv:143.0) Gecko/20100101 Firefox/143.0"
+
+	// DefaultMaxResponseBytes is the default limit on the size of a response body.
+	DefaultMaxResponseBytes = 32 << 20
 )
 
 // Common errors
 var (
-	ErrNotAuthenticated = errors.New("not authenticated: please login first")
+	ErrNotAuthenticated   = errors.New("not authenticated: please login first")
 	ErrInvalidCredentials = errors.New("invalid credentials")
-	ErrEmptyUsername = errors.New("username cannot be empty")
-	ErrEmptyPassword = errors.New("password cannot be empty")
+	ErrEmptyUsername      = errors.New("username cannot be empty")
+	ErrEmptyPassword      = errors.New("password cannot be empty")
+	ErrResponseTooLarge   = errors.New("response body exceeds maximum size")
+	ErrEmptyFundCode      = errors.New("fund code cannot be empty")
+	ErrTokenExpired       = errors.New("access token expired: please login again")
 )
 
 // APIError represents an error response from the Kuvera API.
@@ -79,6 +97,29 @@ func (e *APIError) Error() string {
 	return fmt.Sprintf("API error %d: %s", e.Code, e.Message)
 }
 
+// tokenExpiredMessages are the messages Kuvera sends, in either the message
+// or the error field of a 401 response, when the JWT has expired.
+var tokenExpiredMessages = []string{
+	"token expired",
+	"token has expired",
+	"signature has expired",
+	"jwt expired",
+}
+
+// isTokenExpired reports whether the error describes an expired access token
+// rather than missing or rejected credentials.
+func (e *APIError) isTokenExpired() bool {
+	for _, field := range []string{e.Message, e.Err} {
+		field = strings.ToLower(strings.TrimSpace(field))
+		for _, msg := range tokenExpiredMessages {
+			if strings.Contains(field, msg) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // KuveraClient defines the interface for Kuvera API operations.
 type KuveraClient interface {
 	// Login authenticates with username/password and returns user info and JWT token
@@ -89,6 +130,56 @@ type KuveraClient interface {
 	GetHoldings(ctx context.Context) (*HoldingsResponse, error)
 	// GetGoldPrice retrieves current gold buy/sell prices (requires authentication)
 	GetGoldPrice(ctx context.Context) (*GoldPriceResponse, error)
+	// GetGoals retrieves investment goals with their current progress (requires authentication)
+	GetGoals(ctx context.Context) (*GoalsResponse, error)
+	// GetCapitalGains retrieves realized capital gains for a financial year (requires authentication)
+	GetCapitalGains(ctx context.Context, financialYear string) (*CapitalGainsResponse, error)
+	// GetPortfolioAsOf retrieves a historical portfolio snapshot for a date (requires authentication)
+	GetPortfolioAsOf(ctx context.Context, date time.Time) (*PortfolioResponse, error)
+	// GetWatchlist retrieves the funds on the user's watchlist (requires authentication)
+	GetWatchlist(ctx context.Context) (*WatchlistResponse, error)
+	// AddToWatchlist adds a fund to the user's watchlist (requires authentication)
+	AddToWatchlist(ctx context.Context, fundCode string) error
+	// RemoveFromWatchlist removes a fund from the user's watchlist (requires authentication)
+	RemoveFromWatchlist(ctx context.Context, fundCode string) error
+	// BuyGold buys gold worth an INR amount (requires authentication and WithTransactionsEnabled)
+	BuyGold(ctx context.Context, amount float64) (*GoldOrderResponse, error)
+	// SellGold sells a quantity of gold in grams (requires authentication and WithTransactionsEnabled)
+	SellGold(ctx context.Context, grams float64) (*GoldOrderResponse, error)
+	// GetFundDetails retrieves the metadata of a single fund by fund code
+	GetFundDetails(ctx context.Context, fundCode string) (*FundDetails, error)
+	// GetDividends retrieves dividend (IDCW) payout history (requires authentication)
+	GetDividends(ctx context.Context) (*DividendsResponse, error)
+	// GetPortfolioForAccount retrieves the portfolio of a specific sub-account (requires authentication)
+	GetPortfolioForAccount(ctx context.Context, accountID string) (*PortfolioResponse, error)
+	// CreateSIP registers a new SIP (requires authentication and WithTransactionsEnabled)
+	CreateSIP(ctx context.Context, req SIPCreateRequest) (*SIPDetail, error)
+	// CancelSIP cancels a SIP by ID (requires authentication and WithTransactionsEnabled)
+	CancelSIP(ctx context.Context, sipID int) error
+	// GetEquityHoldings retrieves the per-stock breakdown of Indian equities (requires authentication)
+	GetEquityHoldings(ctx context.Context) (*EquityHoldingsResponse, error)
+	// GetAll fetches the portfolio, holdings and gold price concurrently (requires authentication)
+	GetAll(ctx context.Context) (*Snapshot, error)
+	// GetNAVs retrieves the NAVs of several funds on a date
+	GetNAVs(ctx context.Context, fundCodes []string, date time.Time) (map[string]float64, error)
+	// GetLinkedAccounts retrieves the family accounts linked to the user's login (requires authentication)
+	GetLinkedAccounts(ctx context.Context) (*LinkedAccountsResponse, error)
+	// GetPortfolioHistory retrieves the portfolio's value over a date range (requires authentication)
+	GetPortfolioHistory(ctx context.Context, from, to time.Time, granularity string) (*PortfolioHistoryResponse, error)
+	// ImportCAS uploads a CAMS/KFintech statement to import external holdings (requires authentication)
+	ImportCAS(ctx context.Context, casData []byte, password string) (*CASImportResponse, error)
+	// GetAvailableFunds retrieves a page of the funds open for investment, optionally filtered
+	GetAvailableFunds(ctx context.Context, filter FundFilter) (*FundListResponse, error)
+	// GetEPFDetails retrieves the linked EPF account's balance and contributions (requires authentication)
+	GetEPFDetails(ctx context.Context) (*EPFResponse, error)
+	// GetFundNAVHistory retrieves a fund's NAVs over a date range
+	GetFundNAVHistory(ctx context.Context, fundCode string, from, to time.Time) (*NAVHistoryResponse, error)
+	// GetOrderStatus retrieves the status of an order by reference number (requires authentication)
+	GetOrderStatus(ctx context.Context, refNo string) (*OrderStatusResponse, error)
+	// Ping verifies the API is reachable and the stored token is valid (requires authentication)
+	Ping(ctx context.Context) error
+	// Close releases the client's idle connections and cached responses
+	Close() error
 }
 
 // ClientOption is a function that configures a Client.
@@ -96,9 +187,45 @@ type ClientOption func(*clientConfig)
 
 // clientConfig holds configuration for the client.
 type clientConfig struct {
-	baseURL    string
-	httpClient *http.Client
-	userAgent  string
+	baseURL             string
+	httpClient          *http.Client
+	userAgent           string
+	sessionID           string
+	rateLimit           float64
+	rateBurst           int
+	limiter             *rateLimiter
+	requestSlots        chan struct{}
+	cacheTTL            time.Duration
+	clock               clock
+	requestTimeout      time.Duration
+	maxBodyBytes        int64
+	transactionsEnabled bool
+	insecureSkipVerify  bool
+	forceHTTP1          bool
+	redirectPolicy      *redirectPolicy
+	harRecorder         *harRecorder
+	tolerantDecoding    bool
+	logger              *slog.Logger
+	validateResponses   bool
+	maxRetries          int
+	retryBackoff        time.Duration
+	retryableStatus     map[int]bool
+	dialTimeouts        *dialTimeouts
+	userAgents          *userAgentRotation
+	endpoints           map[string]string
+	tokenRefresh        *tokenRefresher
+	cookieJar           http.CookieJar
+	streamIdleTimeout   time.Duration
+	beforeRequest       func(*http.Request) error
+	apiVersion          string
+	displayCurrency     string
+	minimalHeaders      bool
+	headerJitter        bool
+	maskFolios          bool
+	requestIDs          bool
+	responseTransform   func([]byte) []byte
+	latencyObserver     func(endpoint string, d time.Duration)
+	expectedStatus      map[int]bool
 }
 
 // WithBaseURL sets a custom base URL for the API.
@@ -122,6 +249,35 @@ func WithUserAgent(userAgent string) ClientOption {
 	}
 }
 
+// WithMinimalHeaders sends only the User-Agent, Accept, Content-Type and
+// Authorization headers, instead of also mimicking a browser with Origin,
+// Referer, Sec-Fetch-* and cache headers. Use it with proxies or alternative
+// backends that reject those headers.
+//
+// Headers requested explicitly, such as X-Session-ID, Idempotency-Key and
+// X-Request-ID, are still sent.
+func WithMinimalHeaders() ClientOption {
+	return func(c *clientConfig) {
+		c.minimalHeaders = true
+	}
+}
+
+// WithLogger sets a logger for warnings and diagnostics. By default nothing is logged.
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(c *clientConfig) {
+		c.logger = logger
+	}
+}
+
+// WithSessionID sets the session ID sent as the X-Session-ID header.
+//
+// Login replaces it with the session ID returned by the server, if any.
+func WithSessionID(id string) ClientOption {
+	return func(c *clientConfig) {
+		c.sessionID = id
+	}
+}
+
 // WithTimeout sets a custom timeout for requests.
 func WithTimeout(timeout time.Duration) ClientOption {
 	return func(c *clientConfig) {
@@ -132,13 +288,86 @@ func WithTimeout(timeout time.Duration) ClientOption {
 	}
 }
 
+// WithRequestTimeout sets a logical deadline for each API call, covering the
+// request and reading its response.
+//
+// Unlike WithTimeout, which bounds the underlying HTTP client, this derives a
+// child context per request. A shorter deadline already set on the context
+// passed to a method takes precedence.
+func WithRequestTimeout(timeout time.Duration) ClientOption {
+	return func(c *clientConfig) {
+		c.requestTimeout = timeout
+	}
+}
+
+// WithMaxResponseBytes limits the size of response bodies the client will read.
+//
+// Larger responses fail with ErrResponseTooLarge instead of being buffered in
+// memory. The default is DefaultMaxResponseBytes; zero or a negative value
+// keeps the default. Pass math.MaxInt64 to effectively disable the limit.
+func WithMaxResponseBytes(n int64) ClientOption {
+	return func(c *clientConfig) {
+		if n <= 0 {
+			n = DefaultMaxResponseBytes
+		}
+		c.maxBodyBytes = n
+	}
+}
+
+// WithExpectedStatus treats responses with the given non-2xx status codes as
+// successful, for endpoints that intentionally answer with such a status and
+// a valid body. Their bodies are decoded into the result instead of being
+// reported as an error. Any 2xx status is always successful.
+//
+// Calling WithExpectedStatus again replaces the previous set of codes.
+func WithExpectedStatus(codes ...int) ClientOption {
+	return func(c *clientConfig) {
+		c.expectedStatus = make(map[int]bool, len(codes))
+		for _, code := range codes {
+			c.expectedStatus[code] = true
+		}
+	}
+}
+
 // Client represents a Kuvera API client with authentication and HTTP configuration.
 type Client struct {
-	baseURL     string
-	httpClient  *http.Client
-	userAgent   string
-	accessToken string
-	sessionID   string
+	baseURL             string
+	httpClient          *http.Client
+	userAgent           string
+	accessToken         string
+	sessionID           string
+	tokenMu             sync.RWMutex // guards accessToken and sessionID
+	stats               clientStats
+	inFlight            atomic.Int64
+	limiter             *rateLimiter
+	requestSlots        chan struct{}
+	cache               *responseCache
+	harRecorder         *harRecorder
+	tolerantDecoding    bool
+	clock               clock
+	requestTimeout      time.Duration
+	maxBodyBytes        int64
+	transactionsEnabled bool
+	logger              *slog.Logger
+	validateResponses   bool
+	maxRetries          int
+	retryBackoff        time.Duration
+	retryableStatus     map[int]bool
+	userAgents          *userAgentRotation
+	endpoints           map[string]string
+	tokenRefresh        *tokenRefresher
+	streamIdleTimeout   time.Duration
+	config              clientConfig
+	beforeRequest       func(*http.Request) error
+	apiVersion          string
+	displayCurrency     string
+	minimalHeaders      bool
+	headerJitter        bool
+	maskFolios          bool
+	requestIDs          bool
+	responseTransform   func([]byte) []byte
+	latencyObserver     func(endpoint string, d time.Duration)
+	expectedStatus      map[int]bool
 }
 
 // LoginRequest represents the request payload for user authentication.
@@ -165,8 +394,36 @@ type LoginResponse struct {
 	NewUser bool `json:"new_user"`
 	// Token is the JWT token used for authenticated API calls
 	Token string `json:"token"`
-	// Error contains error message if login failed
+	// SessionID is the session identifier, when returned in the response body
+	SessionID string `json:"session_id,omitempty"`
+	// Error contains error message if login failed. Login fills it in from
+	// Message for failures reported in that field instead.
 	Error string `json:"error,omitempty"`
+	// Message contains the server's explanation, which some failed logins
+	// carry instead of Error
+	Message string `json:"message,omitempty"`
+}
+
+// IsSuccess reports whether the login succeeded, i.e. the status is "success"
+// and no error message was returned.
+func (r *LoginResponse) IsSuccess() bool {
+	return r != nil && r.Status == "success" && r.Error == ""
+}
+
+// loginErrorMessage returns the first non-empty message, trimmed.
+func loginErrorMessage(messages ...string) string {
+	for _, m := range messages {
+		if m = strings.TrimSpace(m); m != "" {
+			return m
+		}
+	}
+	return ""
+}
+
+// isCredentialStatus reports whether a login status code indicates the
+// credentials themselves were rejected.
+func isCredentialStatus(code int) bool {
+	return code == http.StatusBadRequest || code == http.StatusUnauthorized || code == http.StatusForbidden
 }
 
 // GoldData represents gold investment details.
@@ -250,7 +507,7 @@ type FDDetails struct {
 	// AccountID is the account identifier
 	AccountID int `json:"account_id"`
 	// Invested is the amount invested
-	Invested string `json:"invested"`
+	Invested FlexFloat `json:"invested"`
 	// CurrentValue is the current value
 	CurrentValue float64 `json:"current_value"`
 	// OneDayChange is the one-day change
@@ -266,7 +523,7 @@ type FixedDepositData struct {
 	// CurrentValue is the current value of fixed deposits
 	CurrentValue float64 `json:"current_value"`
 	// TotalInvested is the total amount invested
-	TotalInvested string `json:"total_invested"`
+	TotalInvested FlexFloat `json:"total_invested"`
 	// OneDayChange is the one-day change
 	OneDayChange float64 `json:"one_day_change"`
 	// XIRR is the extended internal rate of return
@@ -307,18 +564,18 @@ type PortfolioData struct {
 	AlltimeAbsPercentage float64 `json:"alltime_abs_percentage"`
 	// AlltimeAbsReturn is the all-time absolute return
 	AlltimeAbsReturn float64 `json:"alltime_abs_return"`
-	// USEquities contains US equities data (empty object)
-	USEquities map[string]interface{} `json:"us_equities"`
-	// EPF contains EPF data (empty object)
-	EPF map[string]interface{} `json:"epf"`
+	// USEquities contains US equities data (zero if the user has none)
+	USEquities USEquitiesData `json:"us_equities"`
+	// EPF contains EPF data (zero if the user has none)
+	EPF EPFData `json:"epf"`
 	// Gold contains gold investment data
 	Gold GoldData `json:"gold"`
 	// IndianEquities contains Indian equities data
 	IndianEquities IndianEquitiesData `json:"indian_equities"`
 	// MutualFunds contains mutual funds data
 	MutualFunds MutualFundsData `json:"mutual_funds"`
-	// SaveSmarts contains save smarts data (empty object)
-	SaveSmarts map[string]interface{} `json:"save_smarts"`
+	// SaveSmarts contains save smarts data (zero if the user has none)
+	SaveSmarts SaveSmartsData `json:"save_smarts"`
 	// FixedDeposit contains fixed deposit data
 	FixedDeposit FixedDepositData `json:"fixed_deposit"`
 }
@@ -335,8 +592,9 @@ type PortfolioResponse struct {
 type OrderDetail struct {
 	// Amount is the transaction amount
 	Amount float64 `json:"amount"`
-	// ReinvestAmount is the reinvestment amount (usually null)
-	ReinvestAmount interface{} `json:"reinvest_amount"`
+	// ReinvestAmount is the dividend amount reinvested by this order
+	// (nil for regular purchases)
+	ReinvestAmount *float64 `json:"reinvest_amount"`
 	// NAV is the Net Asset Value at the time of purchase
 	NAV float64 `json:"nav"`
 	// Units is the number of units purchased
@@ -345,6 +603,38 @@ type OrderDetail struct {
 	OrderDate string `json:"order_date"`
 }
 
+// KuveraCategory is the Kuvera categorization of a fund.
+type KuveraCategory string
+
+// Known Kuvera fund categories.
+const (
+	CategoryEquity           KuveraCategory = "Equity"
+	CategoryDebt             KuveraCategory = "Debt"
+	CategoryHybrid           KuveraCategory = "Hybrid"
+	CategorySolutionOriented KuveraCategory = "Solution Oriented"
+	CategoryOther            KuveraCategory = "Other"
+)
+
+// SIPState is the current state of a SIP.
+type SIPState string
+
+// Known SIP states.
+const (
+	SIPStateActive    SIPState = "active"
+	SIPStatePaused    SIPState = "paused"
+	SIPStateCancelled SIPState = "cancelled"
+	SIPStateCompleted SIPState = "completed"
+)
+
+// ValidFlag indicates whether a holding is valid.
+type ValidFlag string
+
+// Known holding validity flags.
+const (
+	ValidFlagValid   ValidFlag = "Y"
+	ValidFlagInvalid ValidFlag = "N"
+)
+
 // SIPDetail represents SIP (Systematic Investment Plan) information.
 type SIPDetail struct {
 	// ID is the unique SIP identifier
@@ -376,7 +666,7 @@ type SIPDetail struct {
 	// UpdatedAt is when the record was last updated
 	UpdatedAt string `json:"updated_at"`
 	// State is the current state of the SIP
-	State string `json:"state"`
+	State SIPState `json:"state"`
 	// PortfolioCode is the portfolio code
 	PortfolioCode string `json:"portfolio_code"`
 	// BSEMessage is the message from BSE
@@ -440,17 +730,17 @@ type Holding struct {
 	// IsSip indicates if this is a SIP investment
 	IsSip bool `json:"isSip"`
 	// KuveraCategory is the Kuvera categorization
-	KuveraCategory string `json:"kuvera_category"`
+	KuveraCategory KuveraCategory `json:"kuvera_category"`
 	// Direct indicates if this is a direct fund
 	Direct bool `json:"direct"`
 	// OrderDetails contains all order/transaction details
 	OrderDetails []OrderDetail `json:"order_details"`
-	// Reason contains any reason (usually empty)
-	Reason interface{} `json:"reason"`
+	// Reason explains why the holding is invalid, if Kuvera says (usually empty)
+	Reason HoldingReason `json:"reason"`
 	// ValidFlag indicates if the holding is valid
-	ValidFlag string `json:"valid_flag"`
+	ValidFlag ValidFlag `json:"valid_flag"`
 	// Source indicates the source of the holding
-	Source string `json:"source"`
+	Source HoldingSource `json:"source"`
 	// SIPs contains SIP details if applicable
 	SIPs []SIPDetail `json:"sips,omitempty"`
 }
@@ -508,41 +798,210 @@ type GoldPriceResponse struct {
 //		kuvera.WithUserAgent("my-app/1.0"),
 //	)
 func NewClient(options ...ClientOption) KuveraClient {
+	// cookiejar.New cannot fail without options
+	jar, _ := cookiejar.New(nil)
 	config := &clientConfig{
-		baseURL:   BaseURL,
-		userAgent: DefaultUserAgent,
+		baseURL:    BaseURL,
+		userAgent:  DefaultUserAgent,
+		apiVersion: DefaultAPIVersion,
 		httpClient: &http.Client{
 			Timeout: DefaultTimeout,
+			Jar:     jar,
 		},
+		clock:        realClock{},
+		maxBodyBytes: DefaultMaxResponseBytes,
 	}
+	WithRetryableStatusCodes(defaultRetryableStatusCodes...)(config)
 
 	for _, option := range options {
 		option(config)
 	}
 
-	return &Client{
-		baseURL:    config.baseURL,
-		httpClient: config.httpClient,
-		userAgent:  config.userAgent,
+	return newClient(config)
+}
+
+// newClient builds a client from a fully configured clientConfig.
+func newClient(config *clientConfig) *Client {
+	// Create the rate limiter once the clock is known. This happens before the
+	// options are kept, so that clones share the limiter.
+	if config.limiter == nil && config.rateLimit > 0 {
+		config.limiter = newRateLimiter(config.rateLimit, config.rateBurst, config.clock)
+	}
+
+	// Keep the options as given, so that Clone can derive a new configuration
+	// before the transport adjustments below are applied
+	options := *config
+
+	if config.cookieJar != nil {
+		config.applyCookieJar()
+	}
+	if config.dialTimeouts != nil {
+		config.applyDialTimeouts()
+	}
+	if config.insecureSkipVerify {
+		config.applyInsecureSkipVerify()
+	}
+	if config.forceHTTP1 {
+		config.applyForceHTTP1()
 	}
+	if config.redirectPolicy != nil {
+		config.applyRedirectPolicy()
+	}
+	if config.harRecorder != nil {
+		config.applyHARRecorder()
+	}
+
+	client := &Client{
+		baseURL:             config.baseURL,
+		httpClient:          config.httpClient,
+		userAgent:           config.userAgent,
+		sessionID:           config.sessionID,
+		limiter:             config.limiter,
+		requestSlots:        config.requestSlots,
+		clock:               config.clock,
+		requestTimeout:      config.requestTimeout,
+		maxBodyBytes:        config.maxBodyBytes,
+		transactionsEnabled: config.transactionsEnabled,
+		logger:              config.logger,
+		validateResponses:   config.validateResponses,
+		maxRetries:          config.maxRetries,
+		retryBackoff:        config.retryBackoff,
+		retryableStatus:     config.retryableStatus,
+		userAgents:          config.userAgents,
+		endpoints:           config.endpoints,
+		tokenRefresh:        config.tokenRefresh,
+		streamIdleTimeout:   config.streamIdleTimeout,
+		beforeRequest:       config.beforeRequest,
+		apiVersion:          config.apiVersion,
+		displayCurrency:     config.displayCurrency,
+		minimalHeaders:      config.minimalHeaders,
+		headerJitter:        config.headerJitter,
+		maskFolios:          config.maskFolios,
+		requestIDs:          config.requestIDs,
+		responseTransform:   config.responseTransform,
+		latencyObserver:     config.latencyObserver,
+		expectedStatus:      config.expectedStatus,
+		harRecorder:         config.harRecorder,
+		tolerantDecoding:    config.tolerantDecoding,
+		config:              options,
+	}
+	if config.cacheTTL > 0 {
+		client.cache = newResponseCache(config.cacheTTL, config.clock)
+	}
+
+	return client
+}
+
+// SessionID returns the session ID sent with each request, if any.
+func (c *Client) SessionID() string {
+	return c.session()
+}
+
+// cancelOnClose releases a request-scoped context once the response body is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
 }
 
 // makeRequest is an internal helper method that handles HTTP request creation and execution.
 // It automatically adds all necessary headers including authentication.
 func (c *Client) makeRequest(ctx context.Context, method, endpoint string, payload interface{}) (*http.Response, error) {
-	// Validate URL
-	apiURL, err := url.JoinPath(c.baseURL, endpoint)
+	if err := c.acquireRequestSlot(ctx); err != nil {
+		return nil, err
+	}
+	resp, err := c.observedRequest(ctx, method, endpoint, payload)
+	if err != nil {
+		c.releaseRequestSlot()
+		return nil, err
+	}
+	resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: c.releaseRequestSlot}
+	return resp, nil
+}
+
+// observedRequest sends the request, reporting its latency to the configured observer.
+func (c *Client) observedRequest(ctx context.Context, method, endpoint string, payload interface{}) (*http.Response, error) {
+	if c.latencyObserver == nil {
+		return c.sendRequest(ctx, method, endpoint, payload)
+	}
+
+	start := time.Now()
+	resp, err := c.sendRequest(ctx, method, endpoint, payload)
+	if err != nil {
+		c.latencyObserver(endpointLabel(endpoint), time.Since(start))
+		return nil, err
+	}
+	resp.Body = &latencyBody{ReadCloser: resp.Body, endpoint: endpointLabel(endpoint), start: start, observe: c.latencyObserver}
+	return resp, nil
+}
+
+// sendRequest applies the timeouts configured for makeRequest and sends the request.
+func (c *Client) sendRequest(ctx context.Context, method, endpoint string, payload interface{}) (*http.Response, error) {
+	ctx = contextOrBackground(ctx)
+	if _, ok := requestIDFromContext(ctx); !ok && c.requestIDs {
+		ctx = WithRequestID(ctx, newRequestID())
+	}
+	payload, err := bufferPayload(payload)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.refreshTokenIfExpiring(ctx); err != nil {
+		return nil, err
+	}
+
+	if c.requestTimeout <= 0 && c.streamIdleTimeout <= 0 {
+		return c.doCountedRequest(ctx, method, endpoint, payload)
+	}
+
+	var cancel context.CancelFunc
+	if c.requestTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, c.requestTimeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	resp, err := c.doCountedRequest(ctx, method, endpoint, payload)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	if c.streamIdleTimeout > 0 {
+		resp.Body = newIdleTimeoutBody(resp.Body, c.streamIdleTimeout, cancel)
+	}
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// doCountedRequest executes a request with retries, recording it in the client's stats.
+func (c *Client) doCountedRequest(ctx context.Context, method, endpoint string, payload interface{}) (*http.Response, error) {
+	resp, err := c.doRequestWithRetry(ctx, method, endpoint, payload)
+	c.stats.record(resp, err, c.isSuccessStatus)
+	c.logRequest(ctx, method, endpoint, resp, err)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = &countingBody{ReadCloser: resp.Body, stats: &c.stats}
+	return resp, nil
+}
+
+// doRequest builds and executes a single request for makeRequest.
+func (c *Client) doRequest(ctx context.Context, method, endpoint string, payload interface{}) (*http.Response, error) {
+	// Validate URL; the query string is kept aside as JoinPath would escape it
+	path, query, _ := strings.Cut(endpoint, "?")
+	apiURL, err := url.JoinPath(c.baseURL, path)
 	if err != nil {
 		return nil, fmt.Errorf("invalid endpoint URL: %w", err)
 	}
+	if query != "" {
+		apiURL += "?" + query
+	}
 
-	var body io.Reader
-	if payload != nil {
-		jsonData, err := json.Marshal(payload)
-		if err != nil {
-			return nil, fmt.Errorf("failed to marshal request body: %w", err)
-		}
-		body = bytes.NewBuffer(jsonData)
+	body, contentType, err := encodePayload(payload)
+	if err != nil {
+		return nil, err
 	}
 
 	req, err := http.NewRequestWithContext(ctx, method, apiURL, body)
@@ -551,29 +1010,67 @@ func (c *Client) makeRequest(ctx context.Context, method, endpoint string, paylo
 	}
 
 	// Set headers to match browser request
-	req.Header.Set("User-Agent", c.userAgent)
+	req.Header.Set("User-Agent", c.requestUserAgent())
 	req.Header.Set("Accept", "application/json, text/plain, */*")
-	req.Header.Set("Accept-Language", "en-US,en;q=0.5")
 	// Don't set Accept-Encoding to avoid compression issues
 	if payload != nil {
-		req.Header.Set("Content-Type", "application/json;charset=utf-8")
+		req.Header.Set("Content-Type", contentType)
+	}
+	if !c.minimalHeaders {
+		req.Header.Set("Accept-Language", "en-US,en;q=0.5")
+		req.Header.Set("Origin", "https://kuvera.in")
+		req.Header.Set("Referer", "https://kuvera.in/")
+		req.Header.Set("Sec-Fetch-Dest", "empty")
+		req.Header.Set("Sec-Fetch-Mode", "cors")
+		req.Header.Set("Sec-Fetch-Site", "same-site")
+		req.Header.Set("Cache-Control", "no-cache")
+		req.Header.Set("Pragma", "no-cache")
+		if c.headerJitter {
+			jitterHeaders(req.Header)
+		}
 	}
-	req.Header.Set("Origin", "https://kuvera.in")
-	req.Header.Set("Referer", "https://kuvera.in/")
-	req.Header.Set("Sec-Fetch-Dest", "empty")
-	req.Header.Set("Sec-Fetch-Mode", "cors")
-	req.Header.Set("Sec-Fetch-Site", "same-site")
-	req.Header.Set("Cache-Control", "no-cache")
-	req.Header.Set("Pragma", "no-cache")
 
 	// Add authentication headers if available
-	if c.accessToken != "" {
-		req.Header.Set("Authorization", "Bearer "+c.accessToken)
+	if token := c.token(); token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
 	} else {
 		req.Header.Set("Authorization", "Bearer")
 	}
-	if c.sessionID != "" {
-		req.Header.Set("X-Session-ID", c.sessionID)
+	if sessionID := c.session(); sessionID != "" {
+		req.Header.Set("X-Session-ID", sessionID)
+	}
+	if key, ok := idempotencyKeyFromContext(ctx); ok {
+		req.Header.Set("Idempotency-Key", key)
+	}
+	if id, ok := requestIDFromContext(ctx); ok {
+		req.Header.Set("X-Request-ID", id)
+	}
+
+	// Serve cached GET responses without touching the network, or revalidate
+	// expired ones that carry an ETag
+	cacheable := c.cache != nil && method == "GET"
+	revalidating := false
+	if cacheable {
+		if cached, ok := c.cache.get(apiURL); ok {
+			return cachedResponse(req, cached), nil
+		}
+		if etag, ok := c.cache.etag(apiURL); ok {
+			req.Header.Set("If-None-Match", etag)
+			revalidating = true
+		}
+	}
+
+	// Wait for the shared rate limiter, if configured
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return nil, fmt.Errorf("rate limit wait failed: %w", err)
+		}
+	}
+
+	if c.beforeRequest != nil {
+		if err := c.beforeRequest(req); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrRequestAborted, err)
+		}
 	}
 
 	resp, err := c.httpClient.Do(req)
@@ -581,46 +1078,133 @@ func (c *Client) makeRequest(ctx context.Context, method, endpoint string, paylo
 		return nil, fmt.Errorf("failed to execute request: %w", err)
 	}
 
+	if revalidating && resp.StatusCode == http.StatusNotModified {
+		if cached, ok := c.cache.revalidate(apiURL); ok {
+			resp.Body.Close()
+			return cachedResponse(req, cached), nil
+		}
+	}
+	if cacheable && resp.StatusCode == http.StatusOK {
+		cached, err := c.readBody(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		c.cache.set(apiURL, cached, resp.Header.Get("ETag"))
+		resp.Body = io.NopCloser(bytes.NewReader(cached))
+	}
+
+	// A successful write, such as adding to the watchlist or buying gold, may
+	// change any cached read, so drop them all
+	if c.cache != nil && method != "GET" && method != "HEAD" && resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		c.cache.clear()
+	}
+
 	return resp, nil
 }
 
+// cachedResponse builds a 200 OK response for req serving body from the cache.
+func cachedResponse(req *http.Request, body []byte) *http.Response {
+	return &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Header:     make(http.Header),
+		Body:       io.NopCloser(bytes.NewReader(body)),
+		Request:    req,
+	}
+}
+
+// readBody reads a response body, failing with ErrResponseTooLarge if it
+// exceeds the configured maximum size.
+func (c *Client) readBody(r io.Reader) ([]byte, error) {
+	// Read one byte past the limit to detect oversized bodies, without
+	// overflowing for a limit of math.MaxInt64
+	body, err := io.ReadAll(io.LimitReader(r, min(c.maxBodyBytes, math.MaxInt64-1)+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	if int64(len(body)) > c.maxBodyBytes {
+		return nil, fmt.Errorf("%w: limit is %d bytes", ErrResponseTooLarge, c.maxBodyBytes)
+	}
+	return body, nil
+}
+
+// isSuccessStatus reports whether a response with the given status carries a
+// result rather than an error: any 2xx status, or one set with WithExpectedStatus.
+func (c *Client) isSuccessStatus(code int) bool {
+	return (code >= 200 && code < 300) || c.expectedStatus[code]
+}
+
 // handleResponse is an internal helper method that processes HTTP responses.
 // It handles response body reading, JSON unmarshaling, and status code validation.
+//
+// A nil result discards the body. A successful response without a body, such
+// as 204 No Content from a write endpoint, leaves result untouched.
 func (c *Client) handleResponse(resp *http.Response, result interface{}, operation string) error {
 	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	body, err := c.readBody(resp.Body)
 	if err != nil {
-		return fmt.Errorf("failed to read response body: %w", err)
+		return err
+	}
+	if c.responseTransform != nil {
+		body = c.responseTransform(body)
 	}
 
 	// Debug: Uncomment the lines below for troubleshooting API responses
 	// fmt.Printf("DEBUG %s Response Status: %d\n", operation, resp.StatusCode)
 	// fmt.Printf("DEBUG %s Response Body: %s\n", operation, string(body))
 
-	// Try to parse as JSON first
-	if err := json.Unmarshal(body, result); err != nil {
-		return fmt.Errorf("failed to parse response (body: %s): %w", string(body), err)
+	// Write endpoints may acknowledge success without a body
+	success := c.isSuccessStatus(resp.StatusCode)
+	if success && (resp.StatusCode == http.StatusNoContent || len(bytes.TrimSpace(body)) == 0) {
+		return nil
 	}
 
-	// Check for non-200 status codes
-	if resp.StatusCode != http.StatusOK {
+	// Check for unsuccessful status codes before decoding, since an error
+	// body rarely has the shape of the expected result
+	if !success {
+		// Decode what we can into result, so that callers such as Login
+		// can inspect fields the result shares with the error body
+		if result != nil {
+			_ = json.Unmarshal(body, result)
+		}
+
 		// Try to extract API error details
 		var apiErr APIError
-		if json.Unmarshal(body, &apiErr) == nil && apiErr.Code != 0 {
+		decoded := json.Unmarshal(body, &apiErr) == nil
+		if resp.StatusCode == http.StatusUnauthorized && decoded && apiErr.isTokenExpired() {
+			return fmt.Errorf("%s failed: %w", operation, ErrTokenExpired)
+		}
+		if decoded && apiErr.Code != 0 {
 			return &apiErr
 		}
 		return fmt.Errorf("%s failed with status code: %d", operation, resp.StatusCode)
 	}
 
+	if result != nil {
+		if err := json.Unmarshal(body, result); err != nil {
+			return fmt.Errorf("failed to parse response (body: %s): %w", string(body), err)
+		}
+	}
+
+	// Run opt-in sanity checks on the decoded response
+	if c.validateResponses {
+		if v, ok := result.(responseValidator); ok {
+			return v.validate(body)
+		}
+	}
+
 	return nil
 }
 
 // Login authenticates the user with Kuvera and stores the access token for subsequent requests.
 //
 // The method sends a POST request to the authentication endpoint with the provided
-// credentials. On successful authentication, the access token is automatically stored
-// in the client and will be included in all subsequent API calls.
+// credentials. On successful authentication, the access token and session ID are
+// automatically stored in the client and will be included in all subsequent API calls.
+// The session ID is taken from the response body, falling back to the X-Session-ID
+// response header.
 //
 // Parameters:
 //   - ctx: Context for cancellation and timeouts
@@ -631,15 +1215,25 @@ func (c *Client) handleResponse(resp *http.Response, result interface{}, operati
 //   - LoginResponse: Contains access token, user ID, and any error details
 //   - error: Any network, parsing, authentication, or validation errors
 //
+// When Kuvera rejects the credentials, the error is ErrInvalidCredentials and
+// the returned LoginResponse is non-nil, with Status and Error populated from
+// the server's reply so that the message can be shown to the user. If the
+// account has been locked after too many failed attempts, the error is an
+// *AccountLockedError wrapping ErrAccountLocked instead, and retrying before
+// it unlocks is pointless.
+//
 // Example:
 //
 //	ctx := context.Background()
 //	client := kuvera.NewClient()
 //	resp, err := client.Login(ctx, "user@example.com", "mypassword")
+//	if errors.Is(err, kuvera.ErrInvalidCredentials) {
+//		log.Fatalf("login rejected: %s", resp.Error)
+//	}
 //	if err != nil {
 //		log.Fatal(err)
 //	}
-//	fmt.Printf("Logged in successfully. User ID: %s\n", resp.Data.UserID)
+//	fmt.Printf("Logged in successfully. Welcome %s\n", resp.Name)
 func (c *Client) Login(ctx context.Context, username, password string) (*LoginResponse, error) {
 	// Input validation
 	if strings.TrimSpace(username) == "" {
@@ -652,10 +1246,10 @@ func (c *Client) Login(ctx context.Context, username, password string) (*LoginRe
 	loginReq := LoginRequest{
 		Email:    username,
 		Password: password,
-		V:        "1.239.2",
+		V:        c.apiVersion,
 	}
 
-	resp, err := c.makeRequest(ctx, "POST", "/api/v5/users/authenticate.json", loginReq)
+	resp, err := c.makeRequest(ctx, "POST", c.endpoint("login"), loginReq)
 	if err != nil {
 		return nil, fmt.Errorf("login request failed: %w", err)
 	}
@@ -664,16 +1258,53 @@ func (c *Client) Login(ctx context.Context, username, password string) (*LoginRe
 
 	// Handle response parsing
 	if err := c.handleResponse(resp, &loginResp, "login"); err != nil {
+		// A locked account must not be mistaken for a wrong password, or
+		// callers would keep retrying and extend the lock
+		messages := []string{loginResp.Error, loginResp.Message}
+		var apiErr *APIError
+		if errors.As(err, &apiErr) {
+			messages = append(messages, apiErr.Message, apiErr.Err)
+		}
+		if lockedErr := c.accountLocked(resp, messages...); lockedErr != nil {
+			return &loginResp, lockedErr
+		}
+
+		// Rejected credentials may come back as a client error status,
+		// with the reason in either the error or the message field
+		message := loginErrorMessage(messages...)
+		loginResp.Error = message
+		if isCredentialStatus(resp.StatusCode) && message != "" {
+			return &loginResp, fmt.Errorf("%w: %s", ErrInvalidCredentials, message)
+		}
+		if message != "" && apiErr == nil {
+			return &loginResp, fmt.Errorf("%w: %s", err, message)
+		}
 		return &loginResp, err
 	}
 
 	// Check for specific login error messages in the response
-	if loginResp.Error != "" || loginResp.Status != "success" {
+	if !loginResp.IsSuccess() {
+		if err := c.accountLocked(resp, loginResp.Error, loginResp.Message); err != nil {
+			return &loginResp, err
+		}
+		if message := loginErrorMessage(loginResp.Error, loginResp.Message); message != "" {
+			loginResp.Error = message
+			return &loginResp, fmt.Errorf("%w: %s", ErrInvalidCredentials, message)
+		}
 		return &loginResp, ErrInvalidCredentials
 	}
 
-	// Store access token in client for subsequent requests
-	c.accessToken = loginResp.Token
+	// Store access token and session ID in client for subsequent requests,
+	// dropping any responses cached for a previous session
+	c.setToken(loginResp.Token)
+	if c.cache != nil {
+		c.cache.clear()
+	}
+	if loginResp.SessionID != "" {
+		c.setSession(loginResp.SessionID)
+	} else if sessionID := resp.Header.Get("X-Session-ID"); sessionID != "" {
+		c.setSession(sessionID)
+	}
 
 	return &loginResp, nil
 }
@@ -698,21 +1329,32 @@ func (c *Client) Login(ctx context.Context, username, password string) (*LoginRe
 //	fmt.Printf("Mutual funds value: ₹%.2f\n", portfolio.Data.MutualFunds.CurrentValue)
 //	fmt.Printf("Overall gain: %.2f%%\n", portfolio.Data.CurrentGainPercent)
 func (c *Client) GetPortfolio(ctx context.Context) (*PortfolioResponse, error) {
-	if c.accessToken == "" {
-		return nil, ErrNotAuthenticated
+	portfolioResp, _, err := c.GetPortfolioWithResponse(ctx)
+	return portfolioResp, err
+}
+
+// GetPortfolioWithResponse is like GetPortfolio but also returns the HTTP response,
+// so that callers can inspect its status code and headers (e.g. rate-limit headers).
+//
+// The response body has already been read and closed. The HTTP response is nil
+// if the request could not be sent. The method is not part of KuveraClient;
+// see GetGoldPriceWithResponse for how to call it.
+func (c *Client) GetPortfolioWithResponse(ctx context.Context) (*PortfolioResponse, *http.Response, error) {
+	if c.token() == "" {
+		return nil, nil, ErrNotAuthenticated
 	}
 
-	resp, err := c.makeRequest(ctx, "GET", "/api/v5/portfolio/returns.json", nil)
+	resp, err := c.makeRequest(ctx, "GET", c.endpoint("portfolio"), nil)
 	if err != nil {
-		return nil, fmt.Errorf("portfolio request failed: %w", err)
+		return nil, nil, fmt.Errorf("portfolio request failed: %w", err)
 	}
 
 	var portfolioResp PortfolioResponse
 	if err := c.handleResponse(resp, &portfolioResp, "portfolio"); err != nil {
-		return &portfolioResp, err
+		return &portfolioResp, resp, err
 	}
 
-	return &portfolioResp, nil
+	return &portfolioResp, resp, nil
 }
 
 // GetHoldings retrieves detailed holdings information for all mutual funds.
@@ -720,6 +1362,7 @@ func (c *Client) GetPortfolio(ctx context.Context) (*PortfolioResponse, error) {
 // This method fetches comprehensive details for each fund holding including
 // folio numbers, units owned, order details, SIP information, and transaction
 // history. The user must be authenticated (logged in) before calling this method.
+// See WithTolerantDecoding to keep the other funds when one fails to decode.
 //
 // Returns:
 //   - HoldingsResponse: Contains detailed holdings information organized by fund code
@@ -738,21 +1381,41 @@ func (c *Client) GetPortfolio(ctx context.Context) (*PortfolioResponse, error) {
 //		}
 //	}
 func (c *Client) GetHoldings(ctx context.Context) (*HoldingsResponse, error) {
-	if c.accessToken == "" {
-		return nil, ErrNotAuthenticated
+	holdingsResp, _, err := c.GetHoldingsWithResponse(ctx)
+	return holdingsResp, err
+}
+
+// GetHoldingsWithResponse is like GetHoldings but also returns the HTTP response,
+// so that callers can inspect its status code and headers.
+//
+// The response body has already been read and closed. The HTTP response is nil
+// if the request could not be sent. The method is not part of KuveraClient;
+// see GetGoldPriceWithResponse for how to call it.
+func (c *Client) GetHoldingsWithResponse(ctx context.Context) (*HoldingsResponse, *http.Response, error) {
+	if c.token() == "" {
+		return nil, nil, ErrNotAuthenticated
 	}
 
-	resp, err := c.makeRequest(ctx, "GET", "/api/v3/portfolio/holdings.json", nil)
+	resp, err := c.makeRequest(ctx, "GET", c.endpoint("holdings"), nil)
 	if err != nil {
-		return nil, fmt.Errorf("holdings request failed: %w", err)
+		return nil, nil, fmt.Errorf("holdings request failed: %w", err)
 	}
 
+	if c.tolerantDecoding {
+		var tolerant tolerantHoldings
+		if err := c.decodeResponse(resp, &tolerant, "holdings"); err != nil {
+			return &tolerant.holdings, resp, err
+		}
+		return &tolerant.holdings, resp, tolerant.err()
+	}
+
+	// Holdings can be large for big accounts, so decode straight from the body
 	var holdingsResp HoldingsResponse
-	if err := c.handleResponse(resp, &holdingsResp, "holdings"); err != nil {
-		return &holdingsResp, err
+	if err := c.decodeResponse(resp, &holdingsResp, "holdings"); err != nil {
+		return &holdingsResp, resp, err
 	}
 
-	return &holdingsResp, nil
+	return &holdingsResp, resp, nil
 }
 
 // GetGoldPrice retrieves the current gold price information from Kuvera's partner.
@@ -773,21 +1436,98 @@ func (c *Client) GetHoldings(ctx context.Context) (*HoldingsResponse, error) {
 //	fmt.Printf("Gold buy: ₹%.2f, sell: ₹%.2f per gram\n",
 //		goldPrice.CurrentGoldPrice.Buy, goldPrice.CurrentGoldPrice.Sell)
 func (c *Client) GetGoldPrice(ctx context.Context) (*GoldPriceResponse, error) {
-	if c.accessToken == "" {
-		return nil, ErrNotAuthenticated
+	goldResp, _, err := c.GetGoldPriceWithResponse(ctx)
+	return goldResp, err
+}
+
+// GetGoldPriceWithResponse is like GetGoldPrice but also returns the HTTP response,
+// so that callers can inspect its status code and headers.
+//
+// The response body has already been read and closed. The HTTP response is nil
+// if the request could not be sent.
+//
+// The method is not part of KuveraClient, so the client returned by NewClient
+// must be asserted to *Client to call it.
+//
+// Example:
+//
+//	client := kuvera.NewClient().(*kuvera.Client)
+//	goldPrice, resp, err := client.GetGoldPriceWithResponse(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Buy ₹%.2f (status %d, remaining quota %s)\n",
+//		goldPrice.CurrentGoldPrice.Buy, resp.StatusCode, resp.Header.Get("X-RateLimit-Remaining"))
+func (c *Client) GetGoldPriceWithResponse(ctx context.Context) (*GoldPriceResponse, *http.Response, error) {
+	return c.getGoldPrice(ctx, true)
+}
+
+// GetGoldPriceFresh is like GetGoldPrice but asks Kuvera for an uncached price.
+//
+// GetGoldPrice is served from Kuvera's server-side cache, which is fast but may
+// lag the partner's live price by a few minutes. GetGoldPriceFresh sends
+// cached=false so the price is fetched from the partner on every call, which is
+// slower and more likely to be rate limited; use it just before a buy or sell
+// decision rather than for dashboards. When the client is constructed with
+// WithCache, the fresh response is itself cached for the TTL like any other GET.
+//
+// Example:
+//
+//	goldPrice, err := client.GetGoldPriceFresh(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Live gold buy: ₹%.2f per gram\n", goldPrice.CurrentGoldPrice.Buy)
+func (c *Client) GetGoldPriceFresh(ctx context.Context) (*GoldPriceResponse, error) {
+	goldResp, _, err := c.getGoldPrice(ctx, false)
+	return goldResp, err
+}
+
+// getGoldPrice fetches the gold price, from Kuvera's cache if cached is true.
+func (c *Client) getGoldPrice(ctx context.Context, cached bool) (*GoldPriceResponse, *http.Response, error) {
+	if c.token() == "" {
+		return nil, nil, ErrNotAuthenticated
 	}
 
 	// Add query parameters as required by the API
-	endpoint := "/api/v3/gold/current_price.json?v=1.239.2&cached=true"
+	endpoint := c.endpoint("gold") + "?v=" + url.QueryEscape(c.apiVersion) + "&cached=" + strconv.FormatBool(cached)
 	resp, err := c.makeRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
-		return nil, fmt.Errorf("gold price request failed: %w", err)
+		return nil, nil, fmt.Errorf("gold price request failed: %w", err)
 	}
 
 	var goldResp GoldPriceResponse
 	if err := c.handleResponse(resp, &goldResp, "gold price"); err != nil {
-		return &goldResp, err
+		return &goldResp, resp, err
+	}
+
+	return &goldResp, resp, nil
+}
+
+// Ping verifies that the Kuvera API is reachable and that the stored access token is valid.
+//
+// It makes a lightweight authenticated profile request and discards the response,
+// which makes it much cheaper than GetPortfolio for liveness checks before a batch job.
+//
+// Returns:
+//   - error: nil if the request succeeds, ErrNotAuthenticated if not logged in,
+//     or the underlying network or API error otherwise
+//
+// Example:
+//
+//	if err := client.Ping(ctx); err != nil {
+//		log.Fatal("Kuvera API unavailable:", err)
+//	}
+func (c *Client) Ping(ctx context.Context) error {
+	if c.token() == "" {
+		return ErrNotAuthenticated
+	}
+
+	resp, err := c.makeRequest(ctx, "GET", "/api/v5/users/profile.json", nil)
+	if err != nil {
+		return fmt.Errorf("ping request failed: %w", err)
 	}
 
-	return &goldResp, nil
+	var profile json.RawMessage
+	return c.handleResponse(resp, &profile, "ping")
 }
@@ -0,0 +1,542 @@
+package kuvera
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newTestClient starts an httptest server serving handler and returns an
+// authenticated client pointed at it.
+func newTestClient(t *testing.T, handler http.HandlerFunc, options ...ClientOption) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	options = append([]ClientOption{WithBaseURL(server.URL)}, options...)
+	client := NewClient(options...).(*Client)
+	client.accessToken = "test-token"
+	return client
+}
+
+func TestPing(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v5/users/profile.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer test-token" {
+			t.Errorf("unexpected Authorization header: %q", got)
+		}
+		w.Write([]byte(`{"status":"success","name":"Test User"}`))
+	})
+
+	if err := client.Ping(context.Background()); err != nil {
+		t.Errorf("Ping() error = %v", err)
+	}
+}
+
+func TestPingNotAuthenticated(t *testing.T) {
+	client := NewClient().(*Client)
+	if err := client.Ping(context.Background()); !errors.Is(err, ErrNotAuthenticated) {
+		t.Errorf("Ping() error = %v, want %v", err, ErrNotAuthenticated)
+	}
+}
+
+func TestPingServerError(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"code":500,"message":"internal server error"}`))
+	})
+
+	err := client.Ping(context.Background())
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Ping() error = %v, want *APIError", err)
+	}
+	if apiErr.Code != 500 {
+		t.Errorf("APIError.Code = %d, want 500", apiErr.Code)
+	}
+}
+
+func TestTokenExpired(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"code":401,"message":"Unauthorized","error":"Signature has expired"}`))
+	})
+
+	_, err := client.GetPortfolio(context.Background())
+	if !errors.Is(err, ErrTokenExpired) {
+		t.Errorf("GetPortfolio() error = %v, want %v", err, ErrTokenExpired)
+	}
+	if errors.Is(err, ErrInvalidCredentials) {
+		t.Errorf("GetPortfolio() error = %v, should not be %v", err, ErrInvalidCredentials)
+	}
+}
+
+// TestTokenExpiredNonObjectResult checks that an error body is not decoded as
+// the expected result when that result is a map or a slice.
+func TestTokenExpiredNonObjectResult(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"code":401,"message":"Token expired"}`))
+	})
+
+	if _, err := client.GetHoldings(context.Background()); !errors.Is(err, ErrTokenExpired) {
+		t.Errorf("GetHoldings() error = %v, want %v", err, ErrTokenExpired)
+	}
+	if _, err := client.GetFundDetails(context.Background(), "LFAG-GR"); !errors.Is(err, ErrTokenExpired) {
+		t.Errorf("GetFundDetails() error = %v, want %v", err, ErrTokenExpired)
+	}
+	if _, err := client.GetAll(context.Background()); !errors.Is(err, ErrTokenExpired) {
+		t.Errorf("GetAll() error = %v, want %v", err, ErrTokenExpired)
+	}
+}
+
+func TestLoginCapturesSessionID(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		body   string
+		want   string
+	}{
+		{"header", "sess-header", `{"status":"success","token":"jwt"}`, "sess-header"},
+		{"body", "", `{"status":"success","token":"jwt","session_id":"sess-body"}`, "sess-body"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/api/v5/users/authenticate.json":
+					if tt.header != "" {
+						w.Header().Set("X-Session-ID", tt.header)
+					}
+					w.Write([]byte(tt.body))
+				default:
+					if got := r.Header.Get("X-Session-ID"); got != tt.want {
+						t.Errorf("X-Session-ID = %q, want %q", got, tt.want)
+					}
+					w.Write([]byte(`{"status":"success"}`))
+				}
+			}, WithSessionID("initial"))
+
+			if _, err := client.Login(context.Background(), "user@example.com", "secret"); err != nil {
+				t.Fatalf("Login() error = %v", err)
+			}
+			if got := client.SessionID(); got != tt.want {
+				t.Errorf("SessionID() = %q, want %q", got, tt.want)
+			}
+			if _, err := client.GetPortfolio(context.Background()); err != nil {
+				t.Fatalf("GetPortfolio() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestWithSessionID(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-Session-ID"); got != "configured" {
+			t.Errorf("X-Session-ID = %q, want %q", got, "configured")
+		}
+		w.Write([]byte(`{}`))
+	}, WithSessionID("configured"))
+
+	if client.SessionID() != "configured" {
+		t.Errorf("SessionID() = %q, want %q", client.SessionID(), "configured")
+	}
+	if err := client.Ping(context.Background()); err != nil {
+		t.Fatalf("Ping() error = %v", err)
+	}
+}
+
+func TestWithRequestTimeout(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}, WithRequestTimeout(50*time.Millisecond))
+
+	start := time.Now()
+	_, err := client.GetPortfolio(context.Background())
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("GetPortfolio() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("GetPortfolio() returned after %v, want about 50ms", elapsed)
+	}
+}
+
+func TestWithRequestTimeoutRespectsShorterDeadline(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}, WithRequestTimeout(time.Minute))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if _, err := client.GetPortfolio(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("GetPortfolio() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("GetPortfolio() returned after %v, want about 50ms", elapsed)
+	}
+}
+
+func TestWithRequestTimeoutCoversBody(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":`))
+		w.(http.Flusher).Flush()
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}, WithRequestTimeout(50*time.Millisecond))
+
+	if _, err := client.GetPortfolio(context.Background()); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("GetPortfolio() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestHoldingTypedConstants(t *testing.T) {
+	body := []byte(`{"FUND1":[{"kuvera_category":"Equity","valid_flag":"Y","sips":[{"state":"active"}]}],
+		"FUND2":[{"kuvera_category":"Debt","valid_flag":"N","sips":[{"state":"paused"}]}],
+		"FUND3":[{"kuvera_category":"Hybrid","valid_flag":"Y","sips":[{"state":"cancelled"}]}]}`)
+
+	var holdings HoldingsResponse
+	if err := json.Unmarshal(body, &holdings); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+
+	tests := []struct {
+		fundCode  string
+		category  KuveraCategory
+		validFlag ValidFlag
+		state     SIPState
+	}{
+		{"FUND1", CategoryEquity, ValidFlagValid, SIPStateActive},
+		{"FUND2", CategoryDebt, ValidFlagInvalid, SIPStatePaused},
+		{"FUND3", CategoryHybrid, ValidFlagValid, SIPStateCancelled},
+	}
+
+	for _, tt := range tests {
+		holding := holdings[tt.fundCode][0]
+		if holding.KuveraCategory != tt.category {
+			t.Errorf("%s KuveraCategory = %q, want %q", tt.fundCode, holding.KuveraCategory, tt.category)
+		}
+		if holding.ValidFlag != tt.validFlag {
+			t.Errorf("%s ValidFlag = %q, want %q", tt.fundCode, holding.ValidFlag, tt.validFlag)
+		}
+		if holding.SIPs[0].State != tt.state {
+			t.Errorf("%s SIP State = %q, want %q", tt.fundCode, holding.SIPs[0].State, tt.state)
+		}
+	}
+}
+
+func TestWithMaxResponseBytes(t *testing.T) {
+	const limit = 1024
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":"success","padding":"`))
+		chunk := bytes.Repeat([]byte("x"), 256)
+		for i := 0; i < 64; i++ {
+			if _, err := w.Write(chunk); err != nil {
+				return
+			}
+		}
+		w.Write([]byte(`"}`))
+	}, WithMaxResponseBytes(limit))
+
+	if _, err := client.GetPortfolio(context.Background()); !errors.Is(err, ErrResponseTooLarge) {
+		t.Errorf("GetPortfolio() error = %v, want %v", err, ErrResponseTooLarge)
+	}
+}
+
+func TestWithMaxResponseBytesWithinLimit(t *testing.T) {
+	body := []byte(`{"status":"success"}`)
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write(body)
+	}, WithMaxResponseBytes(int64(len(body))))
+
+	if _, err := client.GetPortfolio(context.Background()); err != nil {
+		t.Errorf("GetPortfolio() error = %v", err)
+	}
+}
+
+func TestWithMaxResponseBytesEdges(t *testing.T) {
+	tests := []struct {
+		name  string
+		limit int64
+	}{
+		{"zero", 0},
+		{"negative", -1},
+		{"max", math.MaxInt64},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`{"status":"success"}`))
+			}, WithMaxResponseBytes(tt.limit))
+
+			portfolio, err := client.GetPortfolio(context.Background())
+			if err != nil {
+				t.Fatalf("GetPortfolio() error = %v", err)
+			}
+			if portfolio.Status != "success" {
+				t.Errorf("Status = %q, want %q", portfolio.Status, "success")
+			}
+		})
+	}
+}
+
+func TestWithMaxResponseBytesNonPositiveKeepsDefault(t *testing.T) {
+	for _, n := range []int64{0, -1} {
+		client := NewClient(WithMaxResponseBytes(n)).(*Client)
+		if client.maxBodyBytes != DefaultMaxResponseBytes {
+			t.Errorf("WithMaxResponseBytes(%d): maxBodyBytes = %d, want %d", n, client.maxBodyBytes, DefaultMaxResponseBytes)
+		}
+	}
+}
+
+func TestGetGoldPriceWithResponse(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("cached"); got != "true" {
+			t.Errorf("cached = %q, want %q", got, "true")
+		}
+		w.Header().Set("X-RateLimit-Remaining", "42")
+		w.Write([]byte(`{"current_gold_price":{"buy":6150.5,"sell":5990}}`))
+	})
+
+	goldPrice, resp, err := client.GetGoldPriceWithResponse(context.Background())
+	if err != nil {
+		t.Fatalf("GetGoldPriceWithResponse() error = %v", err)
+	}
+	if goldPrice.CurrentGoldPrice.Buy != 6150.5 {
+		t.Errorf("Buy = %v, want 6150.5", goldPrice.CurrentGoldPrice.Buy)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("StatusCode = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if got := resp.Header.Get("X-RateLimit-Remaining"); got != "42" {
+		t.Errorf("X-RateLimit-Remaining = %q, want %q", got, "42")
+	}
+}
+
+func TestGetGoldPriceFresh(t *testing.T) {
+	var cached []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		cached = append(cached, r.URL.Query().Get("cached"))
+		w.Write([]byte(`{"current_gold_price":{"buy":6150.5,"sell":5990}}`))
+	})
+
+	if _, err := client.GetGoldPrice(context.Background()); err != nil {
+		t.Fatalf("GetGoldPrice() error = %v", err)
+	}
+	goldPrice, err := client.GetGoldPriceFresh(context.Background())
+	if err != nil {
+		t.Fatalf("GetGoldPriceFresh() error = %v", err)
+	}
+	if goldPrice.CurrentGoldPrice.Sell != 5990 {
+		t.Errorf("Sell = %v, want 5990", goldPrice.CurrentGoldPrice.Sell)
+	}
+
+	if want := []string{"true", "false"}; !slices.Equal(cached, want) {
+		t.Errorf("cached = %q, want %q", cached, want)
+	}
+}
+
+func TestGetPortfolioWithResponseError(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"code":429,"message":"slow down"}`))
+	})
+
+	_, resp, err := client.GetPortfolioWithResponse(context.Background())
+	if err == nil {
+		t.Fatal("GetPortfolioWithResponse() error = nil, want error")
+	}
+	if resp == nil || resp.StatusCode != http.StatusTooManyRequests || resp.Header.Get("Retry-After") != "30" {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+}
+
+func TestLoginResponseShapes(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		body      string
+		wantErr   error
+		wantOK    bool
+		wantError string
+	}{
+		{
+			name:   "success",
+			status: http.StatusOK,
+			body:   `{"status":"success","name":"Test User","token":"jwt-token"}`,
+			wantOK: true,
+		},
+		{
+			name:      "rejected with 200",
+			status:    http.StatusOK,
+			body:      `{"status":"error","error":"Invalid email or password"}`,
+			wantErr:   ErrInvalidCredentials,
+			wantError: "Invalid email or password",
+		},
+		{
+			name:      "rejected with 401",
+			status:    http.StatusUnauthorized,
+			body:      `{"status":"error","error":"Invalid email or password"}`,
+			wantErr:   ErrInvalidCredentials,
+			wantError: "Invalid email or password",
+		},
+		{
+			name:      "rejected with 401 and message",
+			status:    http.StatusUnauthorized,
+			body:      `{"message":"Email not registered"}`,
+			wantErr:   ErrInvalidCredentials,
+			wantError: "Email not registered",
+		},
+		{
+			name:      "rejected with 200 and message",
+			status:    http.StatusOK,
+			body:      `{"status":"error","message":"Password must be reset"}`,
+			wantErr:   ErrInvalidCredentials,
+			wantError: "Password must be reset",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			})
+			client.accessToken = ""
+
+			resp, err := client.Login(context.Background(), "user@example.com", "secret")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Login() error = %v, want %v", err, tt.wantErr)
+			}
+			if resp == nil {
+				t.Fatal("Login() returned nil response")
+			}
+			if resp.IsSuccess() != tt.wantOK {
+				t.Errorf("IsSuccess() = %v, want %v", resp.IsSuccess(), tt.wantOK)
+			}
+			if resp.Error != tt.wantError {
+				t.Errorf("Error = %q, want %q", resp.Error, tt.wantError)
+			}
+			if err != nil && !strings.Contains(err.Error(), tt.wantError) {
+				t.Errorf("Login() error = %q, want it to contain %q", err, tt.wantError)
+			}
+			if tt.wantOK && client.accessToken != "jwt-token" {
+				t.Errorf("accessToken = %q, want %q", client.accessToken, "jwt-token")
+			}
+			if !tt.wantOK && client.accessToken != "" {
+				t.Errorf("accessToken = %q, want empty after failed login", client.accessToken)
+			}
+		})
+	}
+}
+
+func TestHandleResponseEmptyBody(t *testing.T) {
+	client := NewClient().(*Client)
+
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		result interface{}
+	}{
+		{"204 No Content", http.StatusNoContent, "", &json.RawMessage{}},
+		{"empty 200", http.StatusOK, "", &GoalsResponse{}},
+		{"whitespace 200", http.StatusOK, " \n", &GoalsResponse{}},
+		{"nil result", http.StatusOK, `{"status":"success"}`, nil},
+	}
+	for _, tt := range tests {
+		resp := &http.Response{StatusCode: tt.status, Body: io.NopCloser(strings.NewReader(tt.body))}
+		if err := client.handleResponse(resp, tt.result, "test"); err != nil {
+			t.Errorf("%s: handleResponse() error = %v", tt.name, err)
+		}
+	}
+
+	resp := &http.Response{StatusCode: http.StatusNotFound, Body: io.NopCloser(strings.NewReader(""))}
+	if err := client.handleResponse(resp, nil, "test"); err == nil {
+		t.Error("handleResponse() error = nil for an empty 404, want error")
+	}
+}
+
+func TestHandleResponseSuccessStatuses(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		options []ClientOption
+		wantErr bool
+	}{
+		{"201 Created", http.StatusCreated, nil, false},
+		{"202 Accepted", http.StatusAccepted, nil, false},
+		{"unexpected 409", http.StatusConflict, nil, true},
+		{"expected 409", http.StatusConflict, []ClientOption{WithExpectedStatus(http.StatusConflict)}, false},
+	}
+
+	for _, tt := range tests {
+		client := NewClient(tt.options...).(*Client)
+		resp := &http.Response{
+			StatusCode: tt.status,
+			Body:       io.NopCloser(strings.NewReader(`{"status":"success","data":[{"id":7,"name":"Retirement"}]}`)),
+		}
+
+		var goals GoalsResponse
+		err := client.handleResponse(resp, &goals, "test")
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: handleResponse() error = %v, want error %t", tt.name, err, tt.wantErr)
+		}
+		if !tt.wantErr && (len(goals.Data) != 1 || goals.Data[0].Name != "Retirement") {
+			t.Errorf("%s: decoded %+v, want the response body", tt.name, goals)
+		}
+	}
+}
+
+func TestWithMinimalHeaders(t *testing.T) {
+	browserHeaders := []string{"Accept-Language", "Origin", "Referer", "Sec-Fetch-Dest", "Sec-Fetch-Mode", "Sec-Fetch-Site", "Cache-Control", "Pragma"}
+
+	for _, minimal := range []bool{false, true} {
+		var options []ClientOption
+		if minimal {
+			options = append(options, WithMinimalHeaders())
+		}
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			for _, name := range []string{"User-Agent", "Accept", "Content-Type", "Authorization"} {
+				if r.Header.Get(name) == "" {
+					t.Errorf("minimal=%t: %s header missing", minimal, name)
+				}
+			}
+			for _, name := range browserHeaders {
+				if _, sent := r.Header[name]; sent == minimal {
+					t.Errorf("minimal=%t: %s header sent = %t", minimal, name, sent)
+				}
+			}
+			w.Write([]byte(`{"status":"success"}`))
+		}, options...)
+
+		if err := client.AddToWatchlist(context.Background(), "PPFAS-GR"); err != nil {
+			t.Fatalf("minimal=%t: AddToWatchlist() error = %v", minimal, err)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package kuveramock_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/adjaecent/unofficial-kuvera-api"
+	"github.com/adjaecent/unofficial-kuvera-api/kuveramock"
+)
+
+// portfolioValue is an example consumer that depends only on the client interface.
+func portfolioValue(ctx context.Context, client kuvera.KuveraClient) (float64, error) {
+	portfolio, err := client.GetPortfolio(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return portfolio.Data.CurrentValue, nil
+}
+
+// ExampleMockClient demonstrates injecting a mock into code that depends on KuveraClient.
+func ExampleMockClient() {
+	client := &kuveramock.MockClient{
+		GetPortfolioFunc: func(ctx context.Context) (*kuvera.PortfolioResponse, error) {
+			return &kuvera.PortfolioResponse{
+				Status: "success",
+				Data:   kuvera.PortfolioData{CurrentValue: 125000.50},
+			}, nil
+		},
+	}
+
+	value, err := portfolioValue(context.Background(), client)
+	fmt.Printf("Portfolio value: ₹%.2f, error: %v\n", value, err)
+
+	// Unstubbed methods report that they are not implemented
+	_, err = client.GetHoldings(context.Background())
+	fmt.Println(errors.Is(err, kuveramock.ErrNotImplemented))
+	// Output:
+	// Portfolio value: ₹125000.50, error: <nil>
+	// true
+}
@@ -0,0 +1,299 @@
+// Package kuveramock provides a test double for the kuvera.KuveraClient interface.
+//
+// Each interface method is backed by a settable func field, so tests only need
+// to stub the calls the code under test actually makes:
+//
+//	client := &kuveramock.MockClient{
+//		GetPortfolioFunc: func(ctx context.Context) (*kuvera.PortfolioResponse, error) {
+//			return &kuvera.PortfolioResponse{Status: "success"}, nil
+//		},
+//	}
+//
+// Calling a method whose func field is unset returns an error wrapping
+// ErrNotImplemented.
+package kuveramock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/adjaecent/unofficial-kuvera-api"
+)
+
+// ErrNotImplemented is returned by methods whose func field is not set.
+var ErrNotImplemented = errors.New("not implemented")
+
+// Ensure MockClient satisfies the client interface.
+var _ kuvera.KuveraClient = (*MockClient)(nil)
+
+// MockClient is a kuvera.KuveraClient whose methods delegate to func fields.
+type MockClient struct {
+	LoginFunc                  func(ctx context.Context, username, password string) (*kuvera.LoginResponse, error)
+	GetPortfolioFunc           func(ctx context.Context) (*kuvera.PortfolioResponse, error)
+	GetHoldingsFunc            func(ctx context.Context) (*kuvera.HoldingsResponse, error)
+	GetGoldPriceFunc           func(ctx context.Context) (*kuvera.GoldPriceResponse, error)
+	GetGoalsFunc               func(ctx context.Context) (*kuvera.GoalsResponse, error)
+	GetCapitalGainsFunc        func(ctx context.Context, financialYear string) (*kuvera.CapitalGainsResponse, error)
+	GetPortfolioAsOfFunc       func(ctx context.Context, date time.Time) (*kuvera.PortfolioResponse, error)
+	PingFunc                   func(ctx context.Context) error
+	GetWatchlistFunc           func(ctx context.Context) (*kuvera.WatchlistResponse, error)
+	AddToWatchlistFunc         func(ctx context.Context, fundCode string) error
+	RemoveFromWatchlistFunc    func(ctx context.Context, fundCode string) error
+	BuyGoldFunc                func(ctx context.Context, amount float64) (*kuvera.GoldOrderResponse, error)
+	SellGoldFunc               func(ctx context.Context, grams float64) (*kuvera.GoldOrderResponse, error)
+	GetFundDetailsFunc         func(ctx context.Context, fundCode string) (*kuvera.FundDetails, error)
+	GetDividendsFunc           func(ctx context.Context) (*kuvera.DividendsResponse, error)
+	CreateSIPFunc              func(ctx context.Context, req kuvera.SIPCreateRequest) (*kuvera.SIPDetail, error)
+	CancelSIPFunc              func(ctx context.Context, sipID int) error
+	GetEquityHoldingsFunc      func(ctx context.Context) (*kuvera.EquityHoldingsResponse, error)
+	GetPortfolioForAccountFunc func(ctx context.Context, accountID string) (*kuvera.PortfolioResponse, error)
+	GetAllFunc                 func(ctx context.Context) (*kuvera.Snapshot, error)
+	GetNAVsFunc                func(ctx context.Context, fundCodes []string, date time.Time) (map[string]float64, error)
+	GetLinkedAccountsFunc      func(ctx context.Context) (*kuvera.LinkedAccountsResponse, error)
+	GetPortfolioHistoryFunc    func(ctx context.Context, from, to time.Time, granularity string) (*kuvera.PortfolioHistoryResponse, error)
+	ImportCASFunc              func(ctx context.Context, casData []byte, password string) (*kuvera.CASImportResponse, error)
+	GetAvailableFundsFunc      func(ctx context.Context, filter kuvera.FundFilter) (*kuvera.FundListResponse, error)
+	GetEPFDetailsFunc          func(ctx context.Context) (*kuvera.EPFResponse, error)
+	CloseFunc                  func() error
+	GetFundNAVHistoryFunc      func(ctx context.Context, fundCode string, from, to time.Time) (*kuvera.NAVHistoryResponse, error)
+	GetOrderStatusFunc         func(ctx context.Context, refNo string) (*kuvera.OrderStatusResponse, error)
+}
+
+// notImplemented returns the error for a method whose func field is unset.
+func notImplemented(method string) error {
+	return fmt.Errorf("kuveramock: %s: %w", method, ErrNotImplemented)
+}
+
+// Login calls LoginFunc.
+func (m *MockClient) Login(ctx context.Context, username, password string) (*kuvera.LoginResponse, error) {
+	if m.LoginFunc == nil {
+		return nil, notImplemented("Login")
+	}
+	return m.LoginFunc(ctx, username, password)
+}
+
+// GetPortfolio calls GetPortfolioFunc.
+func (m *MockClient) GetPortfolio(ctx context.Context) (*kuvera.PortfolioResponse, error) {
+	if m.GetPortfolioFunc == nil {
+		return nil, notImplemented("GetPortfolio")
+	}
+	return m.GetPortfolioFunc(ctx)
+}
+
+// GetHoldings calls GetHoldingsFunc.
+func (m *MockClient) GetHoldings(ctx context.Context) (*kuvera.HoldingsResponse, error) {
+	if m.GetHoldingsFunc == nil {
+		return nil, notImplemented("GetHoldings")
+	}
+	return m.GetHoldingsFunc(ctx)
+}
+
+// GetGoldPrice calls GetGoldPriceFunc.
+func (m *MockClient) GetGoldPrice(ctx context.Context) (*kuvera.GoldPriceResponse, error) {
+	if m.GetGoldPriceFunc == nil {
+		return nil, notImplemented("GetGoldPrice")
+	}
+	return m.GetGoldPriceFunc(ctx)
+}
+
+// GetGoals calls GetGoalsFunc.
+func (m *MockClient) GetGoals(ctx context.Context) (*kuvera.GoalsResponse, error) {
+	if m.GetGoalsFunc == nil {
+		return nil, notImplemented("GetGoals")
+	}
+	return m.GetGoalsFunc(ctx)
+}
+
+// GetCapitalGains calls GetCapitalGainsFunc.
+func (m *MockClient) GetCapitalGains(ctx context.Context, financialYear string) (*kuvera.CapitalGainsResponse, error) {
+	if m.GetCapitalGainsFunc == nil {
+		return nil, notImplemented("GetCapitalGains")
+	}
+	return m.GetCapitalGainsFunc(ctx, financialYear)
+}
+
+// GetPortfolioAsOf calls GetPortfolioAsOfFunc.
+func (m *MockClient) GetPortfolioAsOf(ctx context.Context, date time.Time) (*kuvera.PortfolioResponse, error) {
+	if m.GetPortfolioAsOfFunc == nil {
+		return nil, notImplemented("GetPortfolioAsOf")
+	}
+	return m.GetPortfolioAsOfFunc(ctx, date)
+}
+
+// Ping calls PingFunc.
+func (m *MockClient) Ping(ctx context.Context) error {
+	if m.PingFunc == nil {
+		return notImplemented("Ping")
+	}
+	return m.PingFunc(ctx)
+}
+
+// GetWatchlist calls GetWatchlistFunc.
+func (m *MockClient) GetWatchlist(ctx context.Context) (*kuvera.WatchlistResponse, error) {
+	if m.GetWatchlistFunc == nil {
+		return nil, notImplemented("GetWatchlist")
+	}
+	return m.GetWatchlistFunc(ctx)
+}
+
+// AddToWatchlist calls AddToWatchlistFunc.
+func (m *MockClient) AddToWatchlist(ctx context.Context, fundCode string) error {
+	if m.AddToWatchlistFunc == nil {
+		return notImplemented("AddToWatchlist")
+	}
+	return m.AddToWatchlistFunc(ctx, fundCode)
+}
+
+// RemoveFromWatchlist calls RemoveFromWatchlistFunc.
+func (m *MockClient) RemoveFromWatchlist(ctx context.Context, fundCode string) error {
+	if m.RemoveFromWatchlistFunc == nil {
+		return notImplemented("RemoveFromWatchlist")
+	}
+	return m.RemoveFromWatchlistFunc(ctx, fundCode)
+}
+
+// BuyGold calls BuyGoldFunc.
+func (m *MockClient) BuyGold(ctx context.Context, amount float64) (*kuvera.GoldOrderResponse, error) {
+	if m.BuyGoldFunc == nil {
+		return nil, notImplemented("BuyGold")
+	}
+	return m.BuyGoldFunc(ctx, amount)
+}
+
+// SellGold calls SellGoldFunc.
+func (m *MockClient) SellGold(ctx context.Context, grams float64) (*kuvera.GoldOrderResponse, error) {
+	if m.SellGoldFunc == nil {
+		return nil, notImplemented("SellGold")
+	}
+	return m.SellGoldFunc(ctx, grams)
+}
+
+// GetFundDetails calls GetFundDetailsFunc.
+func (m *MockClient) GetFundDetails(ctx context.Context, fundCode string) (*kuvera.FundDetails, error) {
+	if m.GetFundDetailsFunc == nil {
+		return nil, notImplemented("GetFundDetails")
+	}
+	return m.GetFundDetailsFunc(ctx, fundCode)
+}
+
+// GetDividends calls GetDividendsFunc.
+func (m *MockClient) GetDividends(ctx context.Context) (*kuvera.DividendsResponse, error) {
+	if m.GetDividendsFunc == nil {
+		return nil, notImplemented("GetDividends")
+	}
+	return m.GetDividendsFunc(ctx)
+}
+
+// CreateSIP calls CreateSIPFunc.
+func (m *MockClient) CreateSIP(ctx context.Context, req kuvera.SIPCreateRequest) (*kuvera.SIPDetail, error) {
+	if m.CreateSIPFunc == nil {
+		return nil, notImplemented("CreateSIP")
+	}
+	return m.CreateSIPFunc(ctx, req)
+}
+
+// CancelSIP calls CancelSIPFunc.
+func (m *MockClient) CancelSIP(ctx context.Context, sipID int) error {
+	if m.CancelSIPFunc == nil {
+		return notImplemented("CancelSIP")
+	}
+	return m.CancelSIPFunc(ctx, sipID)
+}
+
+// GetEquityHoldings calls GetEquityHoldingsFunc.
+func (m *MockClient) GetEquityHoldings(ctx context.Context) (*kuvera.EquityHoldingsResponse, error) {
+	if m.GetEquityHoldingsFunc == nil {
+		return nil, notImplemented("GetEquityHoldings")
+	}
+	return m.GetEquityHoldingsFunc(ctx)
+}
+
+// GetPortfolioForAccount calls GetPortfolioForAccountFunc.
+func (m *MockClient) GetPortfolioForAccount(ctx context.Context, accountID string) (*kuvera.PortfolioResponse, error) {
+	if m.GetPortfolioForAccountFunc == nil {
+		return nil, notImplemented("GetPortfolioForAccount")
+	}
+	return m.GetPortfolioForAccountFunc(ctx, accountID)
+}
+
+// GetAll calls GetAllFunc.
+func (m *MockClient) GetAll(ctx context.Context) (*kuvera.Snapshot, error) {
+	if m.GetAllFunc == nil {
+		return nil, notImplemented("GetAll")
+	}
+	return m.GetAllFunc(ctx)
+}
+
+// GetNAVs calls GetNAVsFunc.
+func (m *MockClient) GetNAVs(ctx context.Context, fundCodes []string, date time.Time) (map[string]float64, error) {
+	if m.GetNAVsFunc == nil {
+		return nil, notImplemented("GetNAVs")
+	}
+	return m.GetNAVsFunc(ctx, fundCodes, date)
+}
+
+// GetLinkedAccounts calls GetLinkedAccountsFunc.
+func (m *MockClient) GetLinkedAccounts(ctx context.Context) (*kuvera.LinkedAccountsResponse, error) {
+	if m.GetLinkedAccountsFunc == nil {
+		return nil, notImplemented("GetLinkedAccounts")
+	}
+	return m.GetLinkedAccountsFunc(ctx)
+}
+
+// GetPortfolioHistory calls GetPortfolioHistoryFunc.
+func (m *MockClient) GetPortfolioHistory(ctx context.Context, from, to time.Time, granularity string) (*kuvera.PortfolioHistoryResponse, error) {
+	if m.GetPortfolioHistoryFunc == nil {
+		return nil, notImplemented("GetPortfolioHistory")
+	}
+	return m.GetPortfolioHistoryFunc(ctx, from, to, granularity)
+}
+
+// ImportCAS calls ImportCASFunc.
+func (m *MockClient) ImportCAS(ctx context.Context, casData []byte, password string) (*kuvera.CASImportResponse, error) {
+	if m.ImportCASFunc == nil {
+		return nil, notImplemented("ImportCAS")
+	}
+	return m.ImportCASFunc(ctx, casData, password)
+}
+
+// GetAvailableFunds calls GetAvailableFundsFunc.
+func (m *MockClient) GetAvailableFunds(ctx context.Context, filter kuvera.FundFilter) (*kuvera.FundListResponse, error) {
+	if m.GetAvailableFundsFunc == nil {
+		return nil, notImplemented("GetAvailableFunds")
+	}
+	return m.GetAvailableFundsFunc(ctx, filter)
+}
+
+// GetEPFDetails calls GetEPFDetailsFunc.
+func (m *MockClient) GetEPFDetails(ctx context.Context) (*kuvera.EPFResponse, error) {
+	if m.GetEPFDetailsFunc == nil {
+		return nil, notImplemented("GetEPFDetails")
+	}
+	return m.GetEPFDetailsFunc(ctx)
+}
+
+// Close calls CloseFunc.
+func (m *MockClient) Close() error {
+	if m.CloseFunc == nil {
+		return notImplemented("Close")
+	}
+	return m.CloseFunc()
+}
+
+// GetFundNAVHistory calls GetFundNAVHistoryFunc.
+func (m *MockClient) GetFundNAVHistory(ctx context.Context, fundCode string, from, to time.Time) (*kuvera.NAVHistoryResponse, error) {
+	if m.GetFundNAVHistoryFunc == nil {
+		return nil, notImplemented("GetFundNAVHistory")
+	}
+	return m.GetFundNAVHistoryFunc(ctx, fundCode, from, to)
+}
+
+// GetOrderStatus calls GetOrderStatusFunc.
+func (m *MockClient) GetOrderStatus(ctx context.Context, refNo string) (*kuvera.OrderStatusResponse, error) {
+	if m.GetOrderStatusFunc == nil {
+		return nil, notImplemented("GetOrderStatus")
+	}
+	return m.GetOrderStatusFunc(ctx, refNo)
+}
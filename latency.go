@@ -0,0 +1,53 @@
+package kuvera
+
+import (
+	"io"
+	"strings"
+	"time"
+)
+
+// WithLatencyObserver registers a function that is called once per API call
+// with the call's endpoint and duration, for example to feed a Prometheus
+// histogram:
+//
+//	client := kuvera.NewClient(kuvera.WithLatencyObserver(func(endpoint string, d time.Duration) {
+//		requestDuration.WithLabelValues(endpoint).Observe(d.Seconds())
+//	}))
+//
+// The duration covers the whole call: from issuing the request, including any
+// token refresh, rate limiting and retries, until the response body has been
+// read, decoded and closed. Calls that fail without a response are observed
+// when they fail. The endpoint is the request path without its query string,
+// such as "/api/v5/portfolio/returns.json".
+//
+// The observer runs synchronously on the calling goroutine, so it should be
+// fast and must be safe for concurrent use.
+func WithLatencyObserver(fn func(endpoint string, d time.Duration)) ClientOption {
+	return func(c *clientConfig) {
+		c.latencyObserver = fn
+	}
+}
+
+// latencyBody reports the time since start to an observer when it is closed.
+type latencyBody struct {
+	io.ReadCloser
+	endpoint string
+	start    time.Time
+	observe  func(endpoint string, d time.Duration)
+	closed   bool
+}
+
+func (b *latencyBody) Close() error {
+	err := b.ReadCloser.Close()
+	if !b.closed {
+		b.closed = true
+		b.observe(b.endpoint, time.Since(b.start))
+	}
+	return err
+}
+
+// endpointLabel returns the endpoint path without its query string.
+func endpointLabel(endpoint string) string {
+	path, _, _ := strings.Cut(endpoint, "?")
+	return path
+}
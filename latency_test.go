@@ -0,0 +1,51 @@
+package kuvera
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWithLatencyObserver(t *testing.T) {
+	const stall = 20 * time.Millisecond
+
+	type observation struct {
+		endpoint string
+		d        time.Duration
+	}
+	var (
+		mu           sync.Mutex
+		observations []observation
+	)
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		// Stall mid-body so that only a measurement covering the decode sees it
+		w.Write([]byte(`{"status":"success",`))
+		w.(http.Flusher).Flush()
+		time.Sleep(stall)
+		w.Write([]byte(`"data":{"current_value":100}}`))
+	}, WithLatencyObserver(func(endpoint string, d time.Duration) {
+		mu.Lock()
+		defer mu.Unlock()
+		observations = append(observations, observation{endpoint, d})
+	}))
+
+	for range 2 {
+		if _, err := client.GetPortfolio(context.Background()); err != nil {
+			t.Fatalf("GetPortfolio() error = %v", err)
+		}
+	}
+
+	if len(observations) != 2 {
+		t.Fatalf("observer called %d times, want 2", len(observations))
+	}
+	for _, o := range observations {
+		if o.endpoint != "/api/v5/portfolio/returns.json" {
+			t.Errorf("endpoint = %q, want %q", o.endpoint, "/api/v5/portfolio/returns.json")
+		}
+		if o.d < stall || o.d > 5*time.Second {
+			t.Errorf("duration = %v, want at least %v", o.d, stall)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package kuvera
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"testing"
+	"time"
+)
+
+// checkNoGoroutineLeak fails the test if the number of goroutines does not
+// drop back to at most before within a grace period. Goroutines that are
+// winding down (e.g. closing connections) are given time to exit.
+func checkNoGoroutineLeak(t *testing.T, before int) {
+	t.Helper()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		after := runtime.NumGoroutine()
+		if after <= before {
+			return
+		}
+		if time.Now().After(deadline) {
+			buf := make([]byte, 1<<16)
+			n := runtime.Stack(buf, true)
+			t.Fatalf("goroutines leaked: %d before, %d after\n%s", before, after, buf[:n])
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestCancellationDoesNotLeakGoroutines(t *testing.T) {
+	tests := []struct {
+		name    string
+		options []ClientOption
+	}{
+		{"default", nil},
+		{"request timeout", []ClientOption{WithRequestTimeout(time.Minute)}},
+		{"retry and rate limit", []ClientOption{WithRetry(3, time.Millisecond), WithRateLimit(100, 1)}},
+		{"cache", []ClientOption{WithCache(time.Minute)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := runtime.NumGoroutine()
+
+			release := make(chan struct{})
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				select {
+				case <-r.Context().Done():
+				case <-release:
+				}
+			}))
+			transport := &http.Transport{}
+
+			options := append([]ClientOption{
+				WithBaseURL(server.URL),
+				WithHTTPClient(&http.Client{Transport: transport}),
+			}, tt.options...)
+			client := NewClient(options...).(*Client)
+			client.accessToken = "test-token"
+
+			ctx, cancel := context.WithCancel(context.Background())
+			time.AfterFunc(50*time.Millisecond, cancel)
+
+			_, err := client.GetPortfolio(ctx)
+			if !errors.Is(err, context.Canceled) {
+				t.Errorf("GetPortfolio() error = %v, want %v", err, context.Canceled)
+			}
+
+			close(release)
+			server.Close()
+			transport.CloseIdleConnections()
+			checkNoGoroutineLeak(t, before)
+		})
+	}
+}
+
+func TestCancellationMidBodyDoesNotLeakGoroutines(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Send the headers and part of the body, then stall
+		w.Write([]byte(`{"FUND1":[`))
+		w.(http.Flusher).Flush()
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	transport := &http.Transport{}
+
+	client := NewClient(WithBaseURL(server.URL), WithHTTPClient(&http.Client{Transport: transport})).(*Client)
+	client.accessToken = "test-token"
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	if _, err := client.GetHoldings(ctx); err == nil {
+		t.Error("GetHoldings() error = nil, want cancellation error")
+	}
+
+	close(release)
+	server.Close()
+	transport.CloseIdleConnections()
+	checkNoGoroutineLeak(t, before)
+}
+
+func TestGetAllCancellationDoesNotLeakGoroutines(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	transport := &http.Transport{}
+
+	client := NewClient(WithBaseURL(server.URL), WithHTTPClient(&http.Client{Transport: transport})).(*Client)
+	client.accessToken = "test-token"
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	if _, err := client.GetAll(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("GetAll() error = %v, want %v", err, context.Canceled)
+	}
+
+	close(release)
+	server.Close()
+	transport.CloseIdleConnections()
+	checkNoGoroutineLeak(t, before)
+}
+
+func TestFanOutCancellationDoesNotLeakGoroutines(t *testing.T) {
+	// More funds than navConcurrency, so that some lookups are still waiting
+	// for a slot when ctx is cancelled
+	codes := []string{"F1", "F2", "F3", "F4", "F5", "F6", "F7", "F8", "F9", "F10"}
+
+	tests := []struct {
+		name string
+		call func(ctx context.Context, client *Client) error
+	}{
+		{"GetNAVs", func(ctx context.Context, client *Client) error {
+			_, err := client.GetNAVs(ctx, codes, navDate)
+			return err
+		}},
+		{"GetEnrichedHoldings", func(ctx context.Context, client *Client) error {
+			_, err := client.GetEnrichedHoldings(ctx)
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := runtime.NumGoroutine()
+
+			release := make(chan struct{})
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/api/v3/portfolio/holdings.json" {
+					body := "{"
+					for i, code := range codes {
+						if i > 0 {
+							body += ","
+						}
+						body += `"` + code + `":[{"units":1}]`
+					}
+					w.Write([]byte(body + "}"))
+					return
+				}
+				select {
+				case <-r.Context().Done():
+				case <-release:
+				}
+			}))
+			transport := &http.Transport{}
+
+			client := NewClient(WithBaseURL(server.URL), WithHTTPClient(&http.Client{Transport: transport})).(*Client)
+			client.accessToken = "test-token"
+
+			ctx, cancel := context.WithCancel(context.Background())
+			time.AfterFunc(50*time.Millisecond, cancel)
+
+			if err := tt.call(ctx, client); !errors.Is(err, context.Canceled) {
+				t.Errorf("%s() error = %v, want %v", tt.name, err, context.Canceled)
+			}
+
+			close(release)
+			server.Close()
+			transport.CloseIdleConnections()
+			checkNoGoroutineLeak(t, before)
+		})
+	}
+}
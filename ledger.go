@@ -0,0 +1,62 @@
+package kuvera
+
+import (
+	"sort"
+	"time"
+)
+
+// LedgerEntryBuy is the type of ledger entries for purchases.
+const LedgerEntryBuy = "buy"
+
+// LedgerEntry is a single dated transaction, flattened from the order details
+// of a holding for import into accounting software.
+type LedgerEntry struct {
+	// Date is the order date (zero if Kuvera sent an unparseable date)
+	Date time.Time
+	// FundCode is the code of the fund the order was for
+	FundCode string
+	// FolioNumber is the folio the units were allotted to
+	FolioNumber string
+	// Type is the kind of transaction, currently always LedgerEntryBuy
+	Type string
+	// Units is the number of units allotted
+	Units float64
+	// NAV is the NAV the units were allotted at
+	NAV float64
+	// Amount is the order amount in INR
+	Amount float64
+}
+
+// Ledger flattens the order details of every holding into a list of entries
+// sorted by date, oldest first. Entries on the same date are ordered by fund
+// code and then as they appear in the holdings, and orders whose date cannot be
+// parsed come last. It returns an empty, non-nil slice for empty holdings.
+func (h HoldingsResponse) Ledger() []LedgerEntry {
+	entries := make([]LedgerEntry, 0)
+	for _, code := range h.FundCodes() {
+		for _, holding := range h[code] {
+			for _, order := range holding.OrderDetails {
+				// Undated orders keep the zero time and are moved last below
+				date, _ := order.Date()
+				entries = append(entries, LedgerEntry{
+					Date:        date,
+					FundCode:    code,
+					FolioNumber: holding.FolioNumber,
+					Type:        LedgerEntryBuy,
+					Units:       order.Units,
+					NAV:         order.NAV,
+					Amount:      order.Amount,
+				})
+			}
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i].Date, entries[j].Date
+		if a.IsZero() || b.IsZero() {
+			return !a.IsZero() && b.IsZero()
+		}
+		return a.Before(b)
+	})
+	return entries
+}
@@ -0,0 +1,52 @@
+package kuvera
+
+import "testing"
+
+func TestLedger(t *testing.T) {
+	holdings := HoldingsResponse{
+		"BFUND": {{FolioNumber: "B1", OrderDetails: []OrderDetail{
+			{Amount: 3000, NAV: 30, Units: 100, OrderDate: "2023-03-15"},
+			{Amount: 1000, NAV: 10, Units: 100, OrderDate: "2023-01-10"},
+		}}},
+		"AFUND": {
+			{FolioNumber: "A1", OrderDetails: []OrderDetail{
+				{Amount: 2000, NAV: 20, Units: 100, OrderDate: "2023-02-01T10:00:00Z"},
+				{Amount: 500, NAV: 5, Units: 100, OrderDate: "not a date"},
+			}},
+			{FolioNumber: "A2", OrderDetails: []OrderDetail{
+				{Amount: 1500, NAV: 15, Units: 100, OrderDate: "10-01-2023"},
+			}},
+		},
+	}
+
+	want := []struct {
+		date   string
+		code   string
+		folio  string
+		amount float64
+	}{
+		{"2023-01-10", "AFUND", "A2", 1500},
+		{"2023-01-10", "BFUND", "B1", 1000},
+		{"2023-02-01", "AFUND", "A1", 2000},
+		{"2023-03-15", "BFUND", "B1", 3000},
+		{"0001-01-01", "AFUND", "A1", 500},
+	}
+
+	ledger := holdings.Ledger()
+	if len(ledger) != len(want) {
+		t.Fatalf("Ledger() returned %d entries, want %d", len(ledger), len(want))
+	}
+	for i, w := range want {
+		got := ledger[i]
+		if got.Date.Format(dateLayout) != w.date || got.FundCode != w.code || got.FolioNumber != w.folio || got.Amount != w.amount {
+			t.Errorf("Ledger()[%d] = %+v, want %s %s/%s %v", i, got, w.date, w.code, w.folio, w.amount)
+		}
+		if got.Type != LedgerEntryBuy || got.Units != 100 || got.NAV != w.amount/100 {
+			t.Errorf("Ledger()[%d] = %+v, want a buy of 100 units at %v", i, got, w.amount/100)
+		}
+	}
+
+	if ledger := (HoldingsResponse{}).Ledger(); ledger == nil || len(ledger) != 0 {
+		t.Errorf("Ledger() of empty holdings = %#v, want empty slice", ledger)
+	}
+}
@@ -0,0 +1,111 @@
+package kuvera
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrAccountLocked is returned by Login, wrapped in an *AccountLockedError,
+// when Kuvera has locked the account after repeated failed logins. Retrying
+// before the lock expires only prolongs it.
+var ErrAccountLocked = errors.New("account locked after too many login attempts")
+
+// accountLockedMessages are the messages Kuvera sends, in the error or message
+// field of a login response, when the account is locked.
+var accountLockedMessages = []string{
+	"account locked",
+	"account is locked",
+	"account has been locked",
+	"temporarily locked",
+	"too many attempts",
+	"too many login attempts",
+	"too many failed",
+}
+
+// AccountLockedError describes a locked account. It wraps ErrAccountLocked, so
+// callers can test for it with errors.Is:
+//
+//	resp, err := client.Login(ctx, username, password)
+//	var locked *kuvera.AccountLockedError
+//	if errors.As(err, &locked) && locked.RetryAfter > 0 {
+//		log.Printf("account locked, try again in %s", locked.RetryAfter)
+//	}
+type AccountLockedError struct {
+	// Message is the server's explanation, if any
+	Message string
+	// RetryAfter is how long until the account unlocks, taken from the
+	// Retry-After header; zero if the server gave no hint
+	RetryAfter time.Duration
+}
+
+func (e *AccountLockedError) Error() string {
+	msg := ErrAccountLocked.Error()
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	if e.RetryAfter > 0 {
+		msg += fmt.Sprintf(" (retry after %s)", e.RetryAfter)
+	}
+	return msg
+}
+
+func (e *AccountLockedError) Unwrap() error {
+	return ErrAccountLocked
+}
+
+// accountLocked returns an *AccountLockedError if a login response reports a
+// locked account, either with the 423 Locked status or with one of the
+// accountLockedMessages in any of messages, and nil otherwise.
+func (c *Client) accountLocked(resp *http.Response, messages ...string) error {
+	var message string
+	for _, m := range messages {
+		if isAccountLockedMessage(m) {
+			message = strings.TrimSpace(m)
+			break
+		}
+	}
+	if message == "" {
+		if resp.StatusCode != http.StatusLocked {
+			return nil
+		}
+		// Report whatever explanation the server gave with the status
+		for _, m := range messages {
+			if message = strings.TrimSpace(m); message != "" {
+				break
+			}
+		}
+	}
+	return &AccountLockedError{Message: message, RetryAfter: c.retryAfter(resp)}
+}
+
+// isAccountLockedMessage reports whether msg describes a locked account.
+func isAccountLockedMessage(msg string) bool {
+	msg = strings.ToLower(msg)
+	for _, locked := range accountLockedMessages {
+		if strings.Contains(msg, locked) {
+			return true
+		}
+	}
+	return false
+}
+
+// retryAfter parses the Retry-After header of resp, given either in seconds or
+// as an HTTP date. It returns zero if the header is absent, malformed or in
+// the past.
+func (c *Client) retryAfter(resp *http.Response) time.Duration {
+	value := strings.TrimSpace(resp.Header.Get("Retry-After"))
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0)
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		return max(t.Sub(c.clock.Now()), 0)
+	}
+	return 0
+}
@@ -0,0 +1,82 @@
+package kuvera
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestLoginAccountLocked(t *testing.T) {
+	clk := newFakeClock()
+
+	tests := []struct {
+		name           string
+		status         int
+		retryAfter     string
+		body           string
+		wantMessage    string
+		wantRetryAfter time.Duration
+	}{
+		{
+			name:           "423 with seconds",
+			status:         http.StatusLocked,
+			retryAfter:     "900",
+			body:           `{"status":"error","error":"Your account is locked"}`,
+			wantMessage:    "Your account is locked",
+			wantRetryAfter: 15 * time.Minute,
+		},
+		{
+			name:           "429 with HTTP date",
+			status:         http.StatusTooManyRequests,
+			retryAfter:     clk.Now().Add(30 * time.Minute).Format(http.TimeFormat),
+			body:           `{"code":429,"message":"Too many login attempts, please try again later"}`,
+			wantMessage:    "Too many login attempts, please try again later",
+			wantRetryAfter: 30 * time.Minute,
+		},
+		{
+			name:        "rejected with 200",
+			status:      http.StatusOK,
+			body:        `{"status":"error","error":"Account has been locked due to too many failed attempts"}`,
+			wantMessage: "Account has been locked due to too many failed attempts",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if tt.retryAfter != "" {
+					w.Header().Set("Retry-After", tt.retryAfter)
+				}
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}, withClock(clk))
+			client.accessToken = ""
+
+			_, err := client.Login(context.Background(), "user@example.com", "secret")
+			if !errors.Is(err, ErrAccountLocked) || errors.Is(err, ErrInvalidCredentials) {
+				t.Fatalf("Login() error = %v, want %v", err, ErrAccountLocked)
+			}
+			var locked *AccountLockedError
+			if !errors.As(err, &locked) {
+				t.Fatalf("Login() error = %T, want *AccountLockedError", err)
+			}
+			if locked.Message != tt.wantMessage || locked.RetryAfter != tt.wantRetryAfter {
+				t.Errorf("AccountLockedError = %+v, want message %q and retry after %s", locked, tt.wantMessage, tt.wantRetryAfter)
+			}
+		})
+	}
+}
+
+func TestLoginWrongPasswordNotLocked(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"status":"error","error":"Invalid email or password"}`))
+	})
+	client.accessToken = ""
+
+	if _, err := client.Login(context.Background(), "user@example.com", "wrong"); !errors.Is(err, ErrInvalidCredentials) || errors.Is(err, ErrAccountLocked) {
+		t.Errorf("Login() error = %v, want %v", err, ErrInvalidCredentials)
+	}
+}
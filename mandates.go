@@ -0,0 +1,135 @@
+package kuvera
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// NoMandate is the key under which SIPs without a mandate ID are grouped by
+// SIPsByMandate and CommittedAmountByMandate.
+const NoMandate = "(none)"
+
+// SIPsByMandate groups the SIPs in the holdings by the bank mandate they debit.
+//
+// Cancelled and completed SIPs are skipped, as they no longer count towards a
+// mandate's limit; paused SIPs are included since they can resume. SIPs with
+// no mandate ID are grouped under NoMandate.
+func (h HoldingsResponse) SIPsByMandate() map[string][]SIPDetail {
+	byMandate := make(map[string][]SIPDetail)
+	for _, holdings := range h {
+		for _, holding := range holdings {
+			for _, sip := range holding.SIPs {
+				if sip.State == SIPStateCancelled || sip.State == SIPStateCompleted {
+					continue
+				}
+				byMandate[mandateKey(sip.MandateID)] = append(byMandate[mandateKey(sip.MandateID)], sip)
+			}
+		}
+	}
+	return byMandate
+}
+
+// CommittedAmountByMandate returns the monthly amount committed to each bank
+// mandate by the SIPs returned from SIPsByMandate, for checking a new SIP
+// against the mandate's maximum.
+//
+// Weekly installments count 52/12 times a month and quarterly ones a third;
+// SIPs with an unrecognised frequency are counted as monthly so that the
+// commitment is not understated.
+func (h HoldingsResponse) CommittedAmountByMandate() map[string]float64 {
+	committed := make(map[string]float64)
+	for mandate, sips := range h.SIPsByMandate() {
+		for _, sip := range sips {
+			committed[mandate] += sip.Amount * monthlyFactor(sip.Frequency)
+		}
+	}
+	return committed
+}
+
+// ScheduledDebit is the total debited from a bank mandate on one day.
+type ScheduledDebit struct {
+	// Date is the projected debit date
+	Date time.Time
+	// Amount is the sum of the installments due on the date
+	Amount float64
+	// Installments are the SIP installments making up the debit, ordered by
+	// fund code and SIP ID
+	Installments []UpcomingSIP
+}
+
+// MandateDebitSchedule projects every installment debited from a bank mandate
+// between from and from+horizon and aggregates them by date, so that the bank
+// balance can be checked against the total outflow of each day. SIPs debiting
+// on the same day are summed into one ScheduledDebit.
+//
+// Installments are projected as by UpcomingSIPs, except that every installment
+// in the window is included rather than only the next one. Pass NoMandate to
+// project the SIPs without a mandate ID. The debits are sorted by date; an
+// empty, non-nil slice is returned when nothing is due.
+func (h HoldingsResponse) MandateDebitSchedule(mandateID string, horizon time.Duration, from time.Time) []ScheduledDebit {
+	first, last := calendarDate(from), calendarDate(from.Add(horizon))
+	mandate := mandateKey(mandateID)
+
+	byDate := make(map[time.Time]*ScheduledDebit)
+	for fundCode, holdings := range h {
+		for _, holding := range holdings {
+			for _, sip := range holding.SIPs {
+				if mandateKey(sip.MandateID) != mandate {
+					continue
+				}
+				for day := first; ; {
+					next, ok := sip.nextDebit(day)
+					if !ok || next.After(last) {
+						break
+					}
+					debit := byDate[next]
+					if debit == nil {
+						debit = &ScheduledDebit{Date: next}
+						byDate[next] = debit
+					}
+					debit.Amount += sip.Amount
+					debit.Installments = append(debit.Installments, UpcomingSIP{FundCode: fundCode, SIP: sip, Date: next, Amount: sip.Amount})
+					day = next.AddDate(0, 0, 1)
+				}
+			}
+		}
+	}
+
+	schedule := make([]ScheduledDebit, 0, len(byDate))
+	for _, debit := range byDate {
+		sort.Slice(debit.Installments, func(i, j int) bool {
+			a, b := debit.Installments[i], debit.Installments[j]
+			if a.FundCode != b.FundCode {
+				return a.FundCode < b.FundCode
+			}
+			return a.SIP.ID < b.SIP.ID
+		})
+		schedule = append(schedule, *debit)
+	}
+	sort.Slice(schedule, func(i, j int) bool {
+		return schedule[i].Date.Before(schedule[j].Date)
+	})
+	return schedule
+}
+
+// mandateKey returns the SIPsByMandate key for a mandate ID.
+func mandateKey(mandateID string) string {
+	if id := strings.TrimSpace(mandateID); id != "" {
+		return id
+	}
+	return NoMandate
+}
+
+// monthlyFactor converts an installment at the given frequency to a monthly amount.
+func monthlyFactor(frequency string) float64 {
+	f, _ := parseSIPFrequency(frequency)
+	switch f {
+	case SIPFrequencyWeekly:
+		return 52.0 / 12
+	case SIPFrequencyQuarterly:
+		return 1.0 / 3
+	default:
+		return 1
+	}
+}
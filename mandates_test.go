@@ -0,0 +1,100 @@
+package kuvera
+
+import (
+	"math"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestSIPsByMandate(t *testing.T) {
+	holdings := HoldingsResponse{
+		"AXIS-BLUE": {{SIPs: []SIPDetail{
+			{ID: 1, MandateID: "MNDT-A", Amount: 5000, Frequency: "Monthly", State: SIPStateActive},
+			{ID: 2, MandateID: "MNDT-B", Amount: 1200, Frequency: "Weekly", State: SIPStateActive},
+		}}},
+		"UTINI-GR": {
+			{SIPs: []SIPDetail{{ID: 3, MandateID: "MNDT-A", Amount: 9000, Frequency: "Quarterly", State: SIPStatePaused}}},
+			{SIPs: []SIPDetail{
+				{ID: 4, MandateID: "MNDT-B", Amount: 10000, Frequency: "Monthly", State: SIPStateCancelled},
+				{ID: 5, Amount: 700, Frequency: "Monthly", State: SIPStateActive},
+			}},
+		},
+	}
+
+	byMandate := holdings.SIPsByMandate()
+
+	wantIDs := map[string][]int{"MNDT-A": {1, 3}, "MNDT-B": {2}, NoMandate: {5}}
+	if len(byMandate) != len(wantIDs) {
+		t.Fatalf("SIPsByMandate() = %v, want mandates %v", byMandate, wantIDs)
+	}
+	for mandate, want := range wantIDs {
+		var ids []int
+		for _, sip := range byMandate[mandate] {
+			ids = append(ids, sip.ID)
+		}
+		sort.Ints(ids)
+		if !reflect.DeepEqual(ids, want) {
+			t.Errorf("SIP IDs for %s = %v, want %v", mandate, ids, want)
+		}
+	}
+
+	committed := holdings.CommittedAmountByMandate()
+	wantCommitted := map[string]float64{"MNDT-A": 5000 + 3000, "MNDT-B": 1200 * 52 / 12, NoMandate: 700}
+	if len(committed) != len(wantCommitted) {
+		t.Fatalf("CommittedAmountByMandate() = %v, want %v", committed, wantCommitted)
+	}
+	for mandate, want := range wantCommitted {
+		if got := committed[mandate]; math.Abs(got-want) > 1e-9 {
+			t.Errorf("committed[%s] = %v, want %v", mandate, got, want)
+		}
+	}
+}
+
+func TestMandateDebitSchedule(t *testing.T) {
+	holdings := HoldingsResponse{
+		"AXIS-BLUE": {{SIPs: []SIPDetail{
+			{ID: 1, MandateID: "MNDT-A", Amount: 5000, Frequency: "Monthly", StartDate: "2023-06-05", State: SIPStateActive},
+			{ID: 2, MandateID: "MNDT-B", Amount: 1200, Frequency: "Monthly", StartDate: "2023-06-05", State: SIPStateActive},
+		}}},
+		"HDFC-MID": {{SIPs: []SIPDetail{
+			{ID: 3, MandateID: "MNDT-A", Amount: 2500, Frequency: "Monthly", StartDate: "2023-09-05", State: SIPStateActive},
+			{ID: 4, MandateID: "MNDT-A", Amount: 500, Frequency: "Weekly", StartDate: "2024-01-10", State: SIPStateActive},
+			{ID: 5, MandateID: "MNDT-A", Amount: 9000, Frequency: "Monthly", StartDate: "2023-06-05", State: SIPStateCancelled},
+		}}},
+	}
+
+	schedule := holdings.MandateDebitSchedule("MNDT-A", 40*24*time.Hour, date(2024, 2, 1))
+
+	want := []struct {
+		date   time.Time
+		amount float64
+		ids    []int
+	}{
+		{date(2024, 2, 5), 7500, []int{1, 3}}, // two SIPs sharing a debit date
+		{date(2024, 2, 7), 500, []int{4}},
+		{date(2024, 2, 14), 500, []int{4}},
+		{date(2024, 2, 21), 500, []int{4}},
+		{date(2024, 2, 28), 500, []int{4}},
+		{date(2024, 3, 5), 7500, []int{1, 3}},
+		{date(2024, 3, 6), 500, []int{4}},
+	}
+	if len(schedule) != len(want) {
+		t.Fatalf("MandateDebitSchedule() returned %d debits, want %d: %+v", len(schedule), len(want), schedule)
+	}
+	for i, w := range want {
+		var ids []int
+		for _, installment := range schedule[i].Installments {
+			ids = append(ids, installment.SIP.ID)
+		}
+		if !schedule[i].Date.Equal(w.date) || schedule[i].Amount != w.amount || !reflect.DeepEqual(ids, w.ids) {
+			t.Errorf("schedule[%d] = %s ₹%v from SIPs %v, want %s ₹%v from SIPs %v",
+				i, schedule[i].Date.Format(dateLayout), schedule[i].Amount, ids, w.date.Format(dateLayout), w.amount, w.ids)
+		}
+	}
+
+	if got := holdings.MandateDebitSchedule("MNDT-C", 40*24*time.Hour, date(2024, 2, 1)); got == nil || len(got) != 0 {
+		t.Errorf("MandateDebitSchedule() for an unknown mandate = %#v, want empty non-nil slice", got)
+	}
+}
@@ -0,0 +1,156 @@
+package kuvera
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// NAVHistoryResponse represents the response from the NAV history API endpoint.
+type NAVHistoryResponse struct {
+	// Status indicates if the request was successful
+	Status string `json:"status"`
+	// Data contains the fund's NAVs, sorted by date
+	Data []FundNAV `json:"data"`
+}
+
+// GetFundNAVHistory retrieves a fund's NAV on each trading day between from and
+// to, inclusive.
+//
+// The fund code must be valid (see ValidFundCode), from must not be after to,
+// and to must not be in the future. Returns ErrFundNotFound if Kuvera does not
+// know the fund. The NAVs are sorted by date. This endpoint does not require
+// authentication.
+//
+// Example:
+//
+//	to := time.Now()
+//	history, err := client.GetFundNAVHistory(ctx, "INF879O01027", to.AddDate(-1, 0, 0), to)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, nav := range history.Data {
+//		fmt.Printf("%s: %.4f\n", nav.Date, nav.NAV)
+//	}
+func (c *Client) GetFundNAVHistory(ctx context.Context, fundCode string, from, to time.Time) (*NAVHistoryResponse, error) {
+	if err := validateFundCode(fundCode); err != nil {
+		return nil, err
+	}
+	from, to = calendarDate(from), calendarDate(to)
+	if from.After(to) {
+		return nil, fmt.Errorf("%w: %s to %s", ErrInvalidDateRange, from.Format(dateLayout), to.Format(dateLayout))
+	}
+	if to.After(c.clock.Now()) {
+		return nil, fmt.Errorf("%w: %s", ErrFutureDate, to.Format(dateLayout))
+	}
+
+	query := url.Values{"from": {from.Format(dateLayout)}, "to": {to.Format(dateLayout)}}
+	endpoint := "/mf/api/v4/fund_navs/" + url.PathEscape(fundCode) + "/history.json?" + query.Encode()
+	resp, err := c.makeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("NAV history request failed: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, ErrFundNotFound
+	}
+
+	var historyResp NAVHistoryResponse
+	if err := c.handleResponse(resp, &historyResp, "NAV history"); err != nil {
+		return &historyResp, err
+	}
+	if historyResp.Data == nil {
+		historyResp.Data = []FundNAV{}
+	}
+
+	sort.SliceStable(historyResp.Data, func(i, j int) bool {
+		return historyResp.Data[i].Date < historyResp.Data[j].Date
+	})
+	return &historyResp, nil
+}
+
+// ExportNAVHistory downloads the NAV history of each fund between from and to
+// with GetFundNAVHistory and writes it to dir as <fundCode>.csv, with a
+// "date,nav" header. dir is created if needed.
+//
+// The export is resumable: funds whose file already exists are skipped, and
+// files are written under a temporary name and renamed once complete, so an
+// interrupted export never leaves a partial file behind to be skipped. A fund
+// that fails does not stop the export; the error returned joins each fund's
+// failure. The context is checked between funds, and a cancelled export
+// returns the context's error alongside any failures so far.
+//
+// Duplicate and empty codes are ignored.
+//
+// Example:
+//
+//	to := time.Now()
+//	err := client.ExportNAVHistory(ctx, holdings.FundCodes(), to.AddDate(-5, 0, 0), to, "navs")
+//	if err != nil {
+//		log.Printf("some funds were not exported, run again to retry: %v", err)
+//	}
+func (c *Client) ExportNAVHistory(ctx context.Context, fundCodes []string, from, to time.Time, dir string) error {
+	ctx = contextOrBackground(ctx)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create export directory: %w", err)
+	}
+
+	var errs []error
+	for _, code := range uniqueFundCodes(fundCodes) {
+		if err := ctx.Err(); err != nil {
+			errs = append(errs, err)
+			break
+		}
+		if err := c.exportFundNAVHistory(ctx, code, from, to, dir); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", code, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// exportFundNAVHistory writes the NAV history of one fund to dir, unless its
+// file already exists.
+func (c *Client) exportFundNAVHistory(ctx context.Context, fundCode string, from, to time.Time, dir string) error {
+	// Validated codes cannot contain path separators
+	if err := validateFundCode(fundCode); err != nil {
+		return err
+	}
+	path := filepath.Join(dir, fundCode+".csv")
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+
+	history, err := c.GetFundNAVHistory(ctx, fundCode, from, to)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(dir, fundCode+".csv.*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create export file: %w", err)
+	}
+	defer os.Remove(f.Name())
+
+	w := csv.NewWriter(f)
+	w.Write([]string{"date", "nav"})
+	for _, nav := range history.Data {
+		w.Write([]string{nav.Date, strconv.FormatFloat(nav.NAV, 'f', -1, 64)})
+	}
+	w.Flush()
+	if err := errors.Join(w.Error(), f.Close()); err != nil {
+		return fmt.Errorf("failed to write export file: %w", err)
+	}
+	if err := os.Rename(f.Name(), path); err != nil {
+		return fmt.Errorf("failed to write export file: %w", err)
+	}
+	return nil
+}
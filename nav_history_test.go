@@ -0,0 +1,151 @@
+package kuvera
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestGetFundNAVHistory(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/mf/api/v4/fund_navs/PPFAS-GR/history.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("from") + ".." + r.URL.Query().Get("to"); got != "2023-12-01..2023-12-31" {
+			t.Errorf("range = %s, want 2023-12-01..2023-12-31", got)
+		}
+		w.Write([]byte(`{"status":"success","data":[{"nav":78.2,"date":"2023-12-29"},{"nav":77.9,"date":"2023-12-28"}]}`))
+	}, withClock(newFakeClock()))
+
+	from := time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)
+	history, err := client.GetFundNAVHistory(context.Background(), "PPFAS-GR", from, from.AddDate(0, 0, 30))
+	if err != nil {
+		t.Fatalf("GetFundNAVHistory() error = %v", err)
+	}
+	if len(history.Data) != 2 || history.Data[0].Date != "2023-12-28" || history.Data[1].NAV != 78.2 {
+		t.Errorf("Data = %+v, want two NAVs sorted by date", history.Data)
+	}
+}
+
+func TestGetFundNAVHistoryValidation(t *testing.T) {
+	clk := newFakeClock()
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request expected for invalid arguments")
+	}, withClock(clk))
+
+	ctx := context.Background()
+	from := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	if _, err := client.GetFundNAVHistory(ctx, "PPFAS-GR", from, from.AddDate(0, 0, -1)); !errors.Is(err, ErrInvalidDateRange) {
+		t.Errorf("GetFundNAVHistory() with from after to error = %v, want %v", err, ErrInvalidDateRange)
+	}
+	if _, err := client.GetFundNAVHistory(ctx, "PPFAS-GR", from, clk.Now().AddDate(0, 0, 2)); !errors.Is(err, ErrFutureDate) {
+		t.Errorf("GetFundNAVHistory() with future end error = %v, want %v", err, ErrFutureDate)
+	}
+	if _, err := client.GetFundNAVHistory(ctx, "", from, from); !errors.Is(err, ErrEmptyFundCode) {
+		t.Errorf("GetFundNAVHistory() with empty code error = %v, want %v", err, ErrEmptyFundCode)
+	}
+}
+
+// navHistoryHandler serves a one-NAV history for each fund, failing for
+// "BROKEN" and "MISSING", and records the funds requested.
+func navHistoryHandler(mu *sync.Mutex, requested *[]string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		code := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/mf/api/v4/fund_navs/"), "/history.json")
+		mu.Lock()
+		*requested = append(*requested, code)
+		mu.Unlock()
+
+		switch code {
+		case "BROKEN":
+			w.WriteHeader(http.StatusInternalServerError)
+		case "MISSING":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.Write([]byte(`{"status":"success","data":[{"nav":25.5,"date":"2023-12-29"}]}`))
+		}
+	}
+}
+
+func TestExportNAVHistorySkipsExisting(t *testing.T) {
+	var (
+		mu        sync.Mutex
+		requested []string
+	)
+	client := newTestClient(t, navHistoryHandler(&mu, &requested), withClock(newFakeClock()))
+
+	dir := filepath.Join(t.TempDir(), "navs")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	existing := filepath.Join(dir, "AFUND.csv")
+	if err := os.WriteFile(existing, []byte("date,nav\n2023-01-02,10\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	from := time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)
+	if err := client.ExportNAVHistory(context.Background(), []string{"AFUND", "HDFC-MID", "HDFC-MID"}, from, from.AddDate(0, 0, 30), dir); err != nil {
+		t.Fatalf("ExportNAVHistory() error = %v", err)
+	}
+
+	if len(requested) != 1 || requested[0] != "HDFC-MID" {
+		t.Errorf("requested %q, want only HDFC-MID", requested)
+	}
+	if got, _ := os.ReadFile(existing); string(got) != "date,nav\n2023-01-02,10\n" {
+		t.Errorf("existing file was rewritten: %q", got)
+	}
+	if got, _ := os.ReadFile(filepath.Join(dir, "HDFC-MID.csv")); string(got) != "date,nav\n2023-12-29,25.5\n" {
+		t.Errorf("HDFC-MID.csv = %q", got)
+	}
+}
+
+func TestExportNAVHistoryPartialFailure(t *testing.T) {
+	var (
+		mu        sync.Mutex
+		requested []string
+	)
+	client := newTestClient(t, navHistoryHandler(&mu, &requested), withClock(newFakeClock()))
+
+	dir := t.TempDir()
+	from := time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)
+	err := client.ExportNAVHistory(context.Background(), []string{"BROKEN", "AFUND", "MISSING", "../etc"}, from, from.AddDate(0, 0, 30), dir)
+
+	for _, want := range []error{ErrFundNotFound, ErrInvalidFundCode} {
+		if !errors.Is(err, want) {
+			t.Errorf("ExportNAVHistory() error = %v, want it to wrap %v", err, want)
+		}
+	}
+	for _, code := range []string{"BROKEN", "MISSING", "../etc"} {
+		if err == nil || !strings.Contains(err.Error(), code+":") {
+			t.Errorf("ExportNAVHistory() error = %v, want it to mention %s", err, code)
+		}
+	}
+
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 1 || entries[0].Name() != "AFUND.csv" {
+		t.Errorf("dir contains %v, want only AFUND.csv", entries)
+	}
+}
+
+func TestExportNAVHistoryCancelled(t *testing.T) {
+	var (
+		mu        sync.Mutex
+		requested []string
+	)
+	client := newTestClient(t, navHistoryHandler(&mu, &requested), withClock(newFakeClock()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	from := time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)
+	if err := client.ExportNAVHistory(ctx, []string{"AFUND", "HDFC-MID"}, from, from, t.TempDir()); !errors.Is(err, context.Canceled) {
+		t.Errorf("ExportNAVHistory() error = %v, want %v", err, context.Canceled)
+	}
+	if len(requested) != 0 {
+		t.Errorf("requested %q after cancellation, want none", requested)
+	}
+}
@@ -0,0 +1,94 @@
+package kuvera
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// navConcurrency is how many NAV lookups GetNAVs runs at once, since the API
+// has no endpoint to fetch several funds in one request.
+const navConcurrency = 4
+
+// GetNAVs retrieves the NAV of each fund on the given date, keyed by fund code.
+//
+// Duplicate and empty codes are ignored, and malformed codes fail with
+// ErrInvalidFundCode without a request. Kuvera serves one fund per request, so
+// the lookups are made concurrently, a few at a time. If some lookups fail, the
+// NAVs that were found are returned together with an error joining each
+// failure; a fund with no NAV for the date fails with ErrFundNotFound. The date
+// must not be in the future. This endpoint does not require authentication.
+//
+// Example:
+//
+//	date := time.Date(2024, 3, 28, 0, 0, 0, 0, time.UTC)
+//	navs, err := client.GetNAVs(ctx, holdings.FundCodes(), date)
+//	if err != nil {
+//		log.Printf("some NAVs are missing: %v", err)
+//	}
+//	for code, nav := range navs {
+//		fmt.Printf("%s: %.4f\n", code, nav)
+//	}
+func (c *Client) GetNAVs(ctx context.Context, fundCodes []string, date time.Time) (map[string]float64, error) {
+	if date.After(c.clock.Now()) {
+		return nil, fmt.Errorf("%w: %s", ErrFutureDate, date.Format(dateLayout))
+	}
+
+	ctx = contextOrBackground(ctx)
+	codes := uniqueFundCodes(fundCodes)
+	navs, failed := fanOut(ctx, codes, navConcurrency, func(ctx context.Context, code string) (float64, error) {
+		return c.getNAV(ctx, code, date)
+	})
+
+	var errs []error
+	for _, code := range codes {
+		if err, ok := failed[code]; ok {
+			errs = append(errs, fmt.Errorf("%s: %w", code, err))
+		}
+	}
+	return navs, errors.Join(errs...)
+}
+
+// getNAV retrieves the NAV of a single fund on date.
+func (c *Client) getNAV(ctx context.Context, fundCode string, date time.Time) (float64, error) {
+	if err := validateFundCode(fundCode); err != nil {
+		return 0, err
+	}
+
+	query := url.Values{"date": {date.Format(dateLayout)}}
+	endpoint := "/mf/api/v4/fund_navs/" + url.PathEscape(fundCode) + ".json?" + query.Encode()
+	resp, err := c.makeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return 0, fmt.Errorf("NAV request failed: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return 0, ErrFundNotFound
+	}
+
+	var nav FundNAV
+	if err := c.handleResponse(resp, &nav, "NAV"); err != nil {
+		return 0, err
+	}
+	return nav.NAV, nil
+}
+
+// uniqueFundCodes returns the non-empty codes in order of first appearance.
+func uniqueFundCodes(fundCodes []string) []string {
+	seen := make(map[string]bool, len(fundCodes))
+	codes := make([]string, 0, len(fundCodes))
+	for _, code := range fundCodes {
+		code = strings.TrimSpace(code)
+		if code == "" || seen[code] {
+			continue
+		}
+		seen[code] = true
+		codes = append(codes, code)
+	}
+	return codes
+}
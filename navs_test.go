@@ -0,0 +1,93 @@
+package kuvera
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+var navDate = time.Date(2023, 12, 29, 0, 0, 0, 0, time.UTC)
+
+func navHandler(t *testing.T, navs map[string]string, requests map[string]int, mu *sync.Mutex) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("date"); got != "2023-12-29" {
+			t.Errorf("date = %q, want %q", got, "2023-12-29")
+		}
+		code := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/mf/api/v4/fund_navs/"), ".json")
+
+		mu.Lock()
+		requests[code]++
+		mu.Unlock()
+
+		body, ok := navs[code]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(body))
+	}
+}
+
+func TestGetNAVs(t *testing.T) {
+	var mu sync.Mutex
+	requests := make(map[string]int)
+	client := newTestClient(t, navHandler(t, map[string]string{
+		"AXIS-BLUE": `{"nav":52.1234,"date":"2023-12-29"}`,
+		"HDFC-MID":  `{"nav":140.5,"date":"2023-12-29"}`,
+		"PPFAS-GR":  `{"nav":70.25,"date":"2023-12-29"}`,
+	}, requests, &mu), withClock(newFakeClock()))
+
+	navs, err := client.GetNAVs(context.Background(), []string{"AXIS-BLUE", "HDFC-MID", "PPFAS-GR"}, navDate)
+	if err != nil {
+		t.Fatalf("GetNAVs() error = %v", err)
+	}
+
+	want := map[string]float64{"AXIS-BLUE": 52.1234, "HDFC-MID": 140.5, "PPFAS-GR": 70.25}
+	if !reflect.DeepEqual(navs, want) {
+		t.Errorf("GetNAVs() = %v, want %v", navs, want)
+	}
+}
+
+func TestGetNAVsDeduplicates(t *testing.T) {
+	var mu sync.Mutex
+	requests := make(map[string]int)
+	client := newTestClient(t, navHandler(t, map[string]string{
+		"AXIS-BLUE": `{"nav":52.1234}`,
+	}, requests, &mu), withClock(newFakeClock()))
+
+	navs, err := client.GetNAVs(context.Background(), []string{"AXIS-BLUE", "", " ", "AXIS-BLUE"}, navDate)
+	if err != nil {
+		t.Fatalf("GetNAVs() error = %v", err)
+	}
+	if len(navs) != 1 || navs["AXIS-BLUE"] != 52.1234 {
+		t.Errorf("GetNAVs() = %v, want only AXIS-BLUE", navs)
+	}
+	if !reflect.DeepEqual(requests, map[string]int{"AXIS-BLUE": 1}) {
+		t.Errorf("requests = %v, want a single request for AXIS-BLUE", requests)
+	}
+}
+
+func TestGetNAVsPartialFailure(t *testing.T) {
+	var mu sync.Mutex
+	requests := make(map[string]int)
+	client := newTestClient(t, navHandler(t, map[string]string{
+		"AXIS-BLUE": `{"nav":52.1234}`,
+		"BROKEN":    `not json`,
+	}, requests, &mu), withClock(newFakeClock()))
+
+	navs, err := client.GetNAVs(context.Background(), []string{"AXIS-BLUE", "MISSING", "BROKEN"}, navDate)
+	if !errors.Is(err, ErrFundNotFound) {
+		t.Errorf("GetNAVs() error = %v, want it to wrap %v", err, ErrFundNotFound)
+	}
+	if err == nil || !strings.Contains(err.Error(), "MISSING") || !strings.Contains(err.Error(), "BROKEN") {
+		t.Errorf("GetNAVs() error = %v, want failures for MISSING and BROKEN", err)
+	}
+	if !reflect.DeepEqual(navs, map[string]float64{"AXIS-BLUE": 52.1234}) {
+		t.Errorf("GetNAVs() = %v, want the successful lookup", navs)
+	}
+}
@@ -0,0 +1,49 @@
+package kuvera
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// FlexFloat is a number that Kuvera encodes inconsistently, sometimes as a
+// JSON number and sometimes as a quoted string. It decodes from both
+// representations, so a change on the API side does not break parsing.
+//
+// An empty string decodes as zero. It is encoded as a plain JSON number.
+type FlexFloat float64
+
+// Float64 returns the value as a float64.
+func (f FlexFloat) Float64() float64 {
+	return float64(f)
+}
+
+// UnmarshalJSON accepts both quoted and unquoted numbers. As with other types,
+// null leaves the value unchanged.
+func (f *FlexFloat) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if string(data) == "null" {
+		return nil
+	}
+
+	s := string(data)
+	if len(data) > 0 && data[0] == '"' {
+		unquoted, err := strconv.Unquote(s)
+		if err != nil {
+			return fmt.Errorf("invalid number %s: %w", data, err)
+		}
+		s = string(bytes.TrimSpace([]byte(unquoted)))
+		if s == "" {
+			*f = 0
+			return nil
+		}
+	}
+
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+		return fmt.Errorf("invalid number %s", data)
+	}
+	*f = FlexFloat(v)
+	return nil
+}
@@ -0,0 +1,54 @@
+package kuvera
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFlexFloatUnmarshal(t *testing.T) {
+	tests := []struct {
+		input string
+		want  float64
+	}{
+		{`1000.5`, 1000.5},
+		{`"1000.5"`, 1000.5},
+		{`" 19000 "`, 19000},
+		{`""`, 0},
+		{`-42`, -42},
+	}
+
+	for _, tt := range tests {
+		var f FlexFloat
+		if err := json.Unmarshal([]byte(tt.input), &f); err != nil {
+			t.Errorf("Unmarshal(%s) error = %v", tt.input, err)
+			continue
+		}
+		if f.Float64() != tt.want {
+			t.Errorf("Unmarshal(%s) = %v, want %v", tt.input, f.Float64(), tt.want)
+		}
+	}
+}
+
+func TestFlexFloatUnmarshalInvalid(t *testing.T) {
+	for _, input := range []string{`"n/a"`, `"NaN"`, `"Inf"`, `true`, `{}`} {
+		var f FlexFloat
+		if err := json.Unmarshal([]byte(input), &f); err == nil {
+			t.Errorf("Unmarshal(%s) error = nil, want error", input)
+		}
+	}
+}
+
+func TestFixedDepositDataFlexibleNumbers(t *testing.T) {
+	for _, body := range []string{
+		`{"total_invested":"1000.5","fd_details":[{"invested":"1000.5"}]}`,
+		`{"total_invested":1000.5,"fd_details":[{"invested":1000.5}]}`,
+	} {
+		var fd FixedDepositData
+		if err := json.Unmarshal([]byte(body), &fd); err != nil {
+			t.Fatalf("Unmarshal(%s) error = %v", body, err)
+		}
+		if fd.TotalInvested.Float64() != 1000.5 || fd.FDDetails[0].Invested.Float64() != 1000.5 {
+			t.Errorf("Unmarshal(%s) = %+v, want 1000.5 invested", body, fd)
+		}
+	}
+}
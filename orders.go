@@ -0,0 +1,153 @@
+package kuvera
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Order status errors
+var (
+	ErrEmptyRefNo          = errors.New("order reference number cannot be empty")
+	ErrOrderNotFound       = errors.New("order not found")
+	ErrInvalidPollInterval = errors.New("poll interval must be positive")
+)
+
+// OrderState is the processing state of an order.
+type OrderState string
+
+// Known order states.
+const (
+	OrderStatePending   OrderState = "pending"
+	OrderStateCompleted OrderState = "completed"
+	OrderStateFailed    OrderState = "failed"
+)
+
+// Done reports whether the order has reached a final state, completed or
+// failed, and will not change again.
+func (s OrderState) Done() bool {
+	return s == OrderStateCompleted || s == OrderStateFailed
+}
+
+// OrderStatusResponse represents the response from the order status API endpoint.
+type OrderStatusResponse struct {
+	// Status indicates if the request was successful
+	Status string `json:"status"`
+	// RefNo is the order's reference number
+	RefNo string `json:"ref_no"`
+	// FundCode is the scheme code of the fund ordered
+	FundCode string `json:"fund_code"`
+	// State is the processing state of the order
+	State OrderState `json:"order_status"`
+	// Amount is the order amount in INR
+	Amount float64 `json:"amount"`
+	// UnitsAllotted is the number of units allotted (zero until completed)
+	UnitsAllotted float64 `json:"units_allotted"`
+	// NAV is the NAV the units were allotted at (zero until completed)
+	NAV float64 `json:"nav"`
+	// FailureReason explains why the order failed (empty unless failed)
+	FailureReason string `json:"failure_reason"`
+	// UpdatedAt is when the order's state last changed
+	UpdatedAt string `json:"updated_at"`
+}
+
+// GetOrderStatus retrieves the status of an order by its reference number,
+// such as the TxnRefNo, InternalRefNo or BSEOrderNo of a SIPDetail.
+//
+// The reference number must not be empty. Returns ErrOrderNotFound if Kuvera
+// has no order with that reference number. The user must be authenticated
+// (logged in) before calling this method.
+//
+// Example:
+//
+//	order, err := client.GetOrderStatus(ctx, sip.TxnRefNo)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	switch order.State {
+//	case kuvera.OrderStateCompleted:
+//		fmt.Printf("Allotted %.3f units at ₹%.4f\n", order.UnitsAllotted, order.NAV)
+//	case kuvera.OrderStateFailed:
+//		fmt.Println("Order failed:", order.FailureReason)
+//	}
+func (c *Client) GetOrderStatus(ctx context.Context, refNo string) (*OrderStatusResponse, error) {
+	refNo = strings.TrimSpace(refNo)
+	if refNo == "" {
+		return nil, ErrEmptyRefNo
+	}
+	if c.token() == "" {
+		return nil, ErrNotAuthenticated
+	}
+
+	resp, err := c.makeRequest(ctx, "GET", "/api/v3/orders/"+url.PathEscape(refNo)+"/status.json", nil)
+	if err != nil {
+		return nil, fmt.Errorf("order status request failed: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, fmt.Errorf("%w: %s", ErrOrderNotFound, refNo)
+	}
+
+	var orderResp OrderStatusResponse
+	if err := c.handleResponse(resp, &orderResp, "order status"); err != nil {
+		return &orderResp, err
+	}
+
+	return &orderResp, nil
+}
+
+// PollOrderStatus calls GetOrderStatus every interval until until returns true
+// for the status, and returns that status. A nil until waits for the order to
+// reach a final state (see OrderState.Done).
+//
+// Kuvera updates order states asynchronously, so a freshly placed order usually
+// reads as pending for a while. The first status is fetched immediately. If ctx
+// is done first, the last status fetched is returned along with the context's
+// error, so that callers can report how far the order got. An error from
+// GetOrderStatus, such as ErrOrderNotFound, stops polling and is returned along
+// with the last status.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(ctx, 10*time.Minute)
+//	defer cancel()
+//	order, err := client.PollOrderStatus(ctx, refNo, 30*time.Second, nil)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Println("Order", order.State)
+func (c *Client) PollOrderStatus(ctx context.Context, refNo string, interval time.Duration, until func(*OrderStatusResponse) bool) (*OrderStatusResponse, error) {
+	ctx = contextOrBackground(ctx)
+	if interval <= 0 {
+		return nil, ErrInvalidPollInterval
+	}
+	if until == nil {
+		until = func(order *OrderStatusResponse) bool { return order.State.Done() }
+	}
+
+	var last *OrderStatusResponse
+	for {
+		order, err := c.GetOrderStatus(ctx, refNo)
+		if err != nil {
+			if ctx.Err() != nil {
+				return last, fmt.Errorf("order status polling stopped: %w", ctx.Err())
+			}
+			return last, err
+		}
+		last = order
+		if until(order) {
+			return order, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return last, fmt.Errorf("order status polling stopped: %w", ctx.Err())
+		case <-c.clock.After(interval):
+		}
+	}
+}
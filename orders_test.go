@@ -0,0 +1,159 @@
+package kuvera
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestGetOrderStatusValidation(t *testing.T) {
+	client := NewClient()
+	if _, err := client.GetOrderStatus(context.Background(), " "); !errors.Is(err, ErrEmptyRefNo) {
+		t.Errorf("GetOrderStatus() error = %v, want %v", err, ErrEmptyRefNo)
+	}
+	if _, err := client.GetOrderStatus(context.Background(), "TXN123"); !errors.Is(err, ErrNotAuthenticated) {
+		t.Errorf("GetOrderStatus() error = %v, want %v", err, ErrNotAuthenticated)
+	}
+}
+
+func TestGetOrderStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want OrderStatusResponse
+	}{
+		{
+			name: "pending",
+			body: `{"status":"success","ref_no":"TXN123","order_status":"pending","amount":1000}`,
+			want: OrderStatusResponse{Status: "success", RefNo: "TXN123", State: OrderStatePending, Amount: 1000},
+		},
+		{
+			name: "completed",
+			body: `{"status":"success","ref_no":"TXN123","fund_code":"AFUND","order_status":"completed",
+				"amount":1000,"units_allotted":39.216,"nav":25.5,"updated_at":"2024-03-28"}`,
+			want: OrderStatusResponse{Status: "success", RefNo: "TXN123", FundCode: "AFUND", State: OrderStateCompleted,
+				Amount: 1000, UnitsAllotted: 39.216, NAV: 25.5, UpdatedAt: "2024-03-28"},
+		},
+		{
+			name: "failed",
+			body: `{"status":"success","ref_no":"TXN123","order_status":"failed","amount":1000,
+				"failure_reason":"Payment not received"}`,
+			want: OrderStatusResponse{Status: "success", RefNo: "TXN123", State: OrderStateFailed, Amount: 1000,
+				FailureReason: "Payment not received"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/v3/orders/TXN123/status.json" {
+					t.Errorf("unexpected path: %s", r.URL.Path)
+				}
+				w.Write([]byte(tt.body))
+			})
+
+			order, err := client.GetOrderStatus(context.Background(), "TXN123")
+			if err != nil {
+				t.Fatalf("GetOrderStatus() error = %v", err)
+			}
+			if *order != tt.want {
+				t.Errorf("GetOrderStatus() = %+v, want %+v", *order, tt.want)
+			}
+			if done := tt.want.State != OrderStatePending; order.State.Done() != done {
+				t.Errorf("State.Done() = %v, want %v", order.State.Done(), done)
+			}
+		})
+	}
+}
+
+func TestGetOrderStatusNotFound(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"code":404,"message":"not found"}`))
+	})
+
+	if _, err := client.GetOrderStatus(context.Background(), "NOPE"); !errors.Is(err, ErrOrderNotFound) {
+		t.Errorf("GetOrderStatus() error = %v, want %v", err, ErrOrderNotFound)
+	}
+}
+
+func TestPollOrderStatus(t *testing.T) {
+	var requests int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests <= 2 {
+			w.Write([]byte(`{"status":"success","ref_no":"TXN123","order_status":"pending"}`))
+			return
+		}
+		w.Write([]byte(`{"status":"success","ref_no":"TXN123","order_status":"completed","units_allotted":39.216}`))
+	}, withClock(newFakeClock()))
+
+	order, err := client.PollOrderStatus(context.Background(), "TXN123", time.Minute, nil)
+	if err != nil {
+		t.Fatalf("PollOrderStatus() error = %v", err)
+	}
+	if order.State != OrderStateCompleted || order.UnitsAllotted != 39.216 {
+		t.Errorf("PollOrderStatus() = %+v, want completed order", order)
+	}
+	if requests != 3 {
+		t.Errorf("requests = %d, want 3", requests)
+	}
+}
+
+func TestPollOrderStatusContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var requests int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if requests++; requests == 2 {
+			cancel()
+		}
+		w.Write([]byte(`{"status":"success","ref_no":"TXN123","order_status":"pending"}`))
+	}, withClock(newFakeClock()))
+
+	order, err := client.PollOrderStatus(ctx, "TXN123", time.Minute, func(order *OrderStatusResponse) bool {
+		return order.State == OrderStateCompleted
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("PollOrderStatus() error = %v, want %v", err, context.Canceled)
+	}
+	if order == nil || order.State != OrderStatePending {
+		t.Errorf("PollOrderStatus() = %+v, want last pending status", order)
+	}
+}
+
+func TestPollOrderStatusNilContext(t *testing.T) {
+	var requests int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if requests++; requests == 1 {
+			w.Write([]byte(`{"status":"success","ref_no":"TXN123","order_status":"pending"}`))
+			return
+		}
+		w.Write([]byte(`{"status":"success","ref_no":"TXN123","order_status":"failed"}`))
+	}, withClock(newFakeClock()))
+
+	var ctx context.Context
+	order, err := client.PollOrderStatus(ctx, "TXN123", time.Minute, nil)
+	if err != nil {
+		t.Fatalf("PollOrderStatus(nil) error = %v", err)
+	}
+	if order.State != OrderStateFailed {
+		t.Errorf("State = %q, want %q", order.State, OrderStateFailed)
+	}
+}
+
+func TestPollOrderStatusErrors(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}, withClock(newFakeClock()))
+
+	if _, err := client.PollOrderStatus(context.Background(), "TXN123", 0, nil); !errors.Is(err, ErrInvalidPollInterval) {
+		t.Errorf("PollOrderStatus() error = %v, want %v", err, ErrInvalidPollInterval)
+	}
+	if _, err := client.PollOrderStatus(context.Background(), "TXN123", time.Minute, nil); !errors.Is(err, ErrOrderNotFound) {
+		t.Errorf("PollOrderStatus() error = %v, want %v", err, ErrOrderNotFound)
+	}
+}
@@ -0,0 +1,60 @@
+package kuvera
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// ClientPool holds authenticated clients for several Kuvera accounts, keyed by
+// a name chosen by the caller. It is safe for concurrent use.
+//
+// Example:
+//
+//	pool := kuvera.NewClientPool(kuvera.WithRateLimit(2, 2))
+//	if err := pool.Add(ctx, "self", "me@example.com", myPassword); err != nil {
+//		log.Fatal(err)
+//	}
+//	if err := pool.Add(ctx, "parents", "parents@example.com", theirPassword); err != nil {
+//		log.Fatal(err)
+//	}
+//	client, _ := pool.Get("parents")
+//	portfolio, err := client.GetPortfolio(ctx)
+type ClientPool struct {
+	options []ClientOption
+	mu      sync.RWMutex
+	clients map[string]KuveraClient
+}
+
+// NewClientPool returns an empty pool whose clients are created with the given options.
+func NewClientPool(options ...ClientOption) *ClientPool {
+	return &ClientPool{
+		options: options,
+		clients: make(map[string]KuveraClient),
+	}
+}
+
+// Add creates a client, logs it in with the given credentials and stores it
+// under name, replacing any client previously stored under that name.
+//
+// Logins for different names run concurrently. If the login fails, the error
+// is returned and the pool is left unchanged.
+func (p *ClientPool) Add(ctx context.Context, name, username, password string) error {
+	client := NewClient(p.options...)
+	if _, err := client.Login(ctx, username, password); err != nil {
+		return fmt.Errorf("login for %s failed: %w", name, err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.clients[name] = client
+	return nil
+}
+
+// Get returns the client stored under name.
+func (p *ClientPool) Get(name string) (KuveraClient, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	client, ok := p.clients[name]
+	return client, ok
+}
@@ -0,0 +1,68 @@
+package kuvera
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestClientPool(t *testing.T) {
+	values := map[string]float64{"Bearer token-a": 1000, "Bearer token-b": 2000}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == defaultEndpoints["login"] {
+			var req LoginRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			if req.Password != "secret" {
+				w.Write([]byte(`{"status":"error","error":"Invalid email or password"}`))
+				return
+			}
+			json.NewEncoder(w).Encode(LoginResponse{Status: "success", Token: "token-" + req.Email})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]any{
+			"status": "success",
+			"data":   map[string]float64{"current_value": values[r.Header.Get("Authorization")]},
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	pool := NewClientPool(WithBaseURL(server.URL))
+
+	var wg sync.WaitGroup
+	for name, email := range map[string]string{"self": "a", "parents": "b"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := pool.Add(ctx, name, email, "secret"); err != nil {
+				t.Errorf("Add(%q) error = %v", name, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	for name, want := range map[string]float64{"self": 1000, "parents": 2000} {
+		client, ok := pool.Get(name)
+		if !ok {
+			t.Fatalf("Get(%q) found no client", name)
+		}
+		portfolio, err := client.GetPortfolio(ctx)
+		if err != nil {
+			t.Fatalf("GetPortfolio() for %s error = %v", name, err)
+		}
+		if portfolio.Data.CurrentValue != want {
+			t.Errorf("CurrentValue for %s = %v, want %v", name, portfolio.Data.CurrentValue, want)
+		}
+	}
+
+	if err := pool.Add(ctx, "other", "c", "wrong"); !errors.Is(err, ErrInvalidCredentials) {
+		t.Errorf("Add() error = %v, want %v", err, ErrInvalidCredentials)
+	}
+	if _, ok := pool.Get("other"); ok {
+		t.Error("Get() found a client whose login failed")
+	}
+}
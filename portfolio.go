@@ -0,0 +1,107 @@
+package kuvera
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Portfolio snapshot errors
+var (
+	ErrFutureDate     = errors.New("date cannot be in the future")
+	ErrNoDataForDate  = errors.New("no portfolio data for date")
+	ErrEmptyAccountID = errors.New("account ID cannot be empty")
+)
+
+// dateLayout is the layout Kuvera uses for dates in query parameters.
+const dateLayout = "2006-01-02"
+
+// isEmpty reports whether the portfolio carries no values at all.
+func (p PortfolioData) isEmpty() bool {
+	return p.CurrentValue == 0 && p.Invested == 0 &&
+		p.CurrentValueAssets == 0 && p.InvestedValueAssets == 0
+}
+
+// GetPortfolioAsOf retrieves a historical snapshot of the portfolio on the given date.
+//
+// The date must not be in the future. If Kuvera has no data for the date, for
+// example because it predates the account, ErrNoDataForDate is returned rather
+// than an empty portfolio. The user must be authenticated (logged in) before
+// calling this method.
+//
+// Returns:
+//   - PortfolioResponse: Contains the portfolio data as of the given date
+//   - error: Validation errors, authentication errors, network errors, or API errors
+//
+// Example:
+//
+//	lastYear := time.Now().AddDate(-1, 0, 0)
+//	portfolio, err := client.GetPortfolioAsOf(ctx, lastYear)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Value a year ago: ₹%.2f\n", portfolio.Data.CurrentValue)
+func (c *Client) GetPortfolioAsOf(ctx context.Context, date time.Time) (*PortfolioResponse, error) {
+	if date.After(c.clock.Now()) {
+		return nil, fmt.Errorf("%w: %s", ErrFutureDate, date.Format(dateLayout))
+	}
+	if c.token() == "" {
+		return nil, ErrNotAuthenticated
+	}
+
+	query := url.Values{"date": {date.Format(dateLayout)}}
+	resp, err := c.makeRequest(ctx, "GET", c.endpoint("portfolio")+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("portfolio request failed: %w", err)
+	}
+
+	var portfolioResp PortfolioResponse
+	if err := c.handleResponse(resp, &portfolioResp, "portfolio"); err != nil {
+		return &portfolioResp, err
+	}
+
+	if portfolioResp.Data.isEmpty() {
+		return &portfolioResp, fmt.Errorf("%w: %s", ErrNoDataForDate, date.Format(dateLayout))
+	}
+
+	return &portfolioResp, nil
+}
+
+// GetPortfolioForAccount retrieves the portfolio of a specific sub-account, such
+// as a family member's account linked to the user's login. Account IDs are
+// listed by GetLinkedAccounts. Use GetPortfolio for the default account.
+//
+// The account ID must not be empty. The user must be authenticated (logged in)
+// before calling this method.
+//
+// Example:
+//
+//	portfolio, err := client.GetPortfolioForAccount(ctx, "FAM123")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Family account value: ₹%.2f\n", portfolio.Data.CurrentValue)
+func (c *Client) GetPortfolioForAccount(ctx context.Context, accountID string) (*PortfolioResponse, error) {
+	if strings.TrimSpace(accountID) == "" {
+		return nil, ErrEmptyAccountID
+	}
+	if c.token() == "" {
+		return nil, ErrNotAuthenticated
+	}
+
+	query := url.Values{"account_id": {accountID}}
+	resp, err := c.makeRequest(ctx, "GET", c.endpoint("portfolio")+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("portfolio request failed: %w", err)
+	}
+
+	var portfolioResp PortfolioResponse
+	if err := c.handleResponse(resp, &portfolioResp, "portfolio"); err != nil {
+		return &portfolioResp, err
+	}
+
+	return &portfolioResp, nil
+}
@@ -0,0 +1,291 @@
+package kuvera
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Metrics flattens the portfolio into a map of dotted metric names to values,
+// suitable for exporting to a time-series database.
+//
+// Keys follow the JSON field names, e.g. "current_gain_percent",
+// "mutual_funds.current_value" or "gold.kuvera.xirr". String-encoded figures
+// such as gold XIRR are parsed to floats; values that cannot be parsed are
+// omitted. Optional asset classes (US equities, EPF, save smarts) contribute
+// keys only when the user holds them, so empty objects add no keys.
+func (p PortfolioData) Metrics() map[string]float64 {
+	m := map[string]float64{
+		"current_value":          p.CurrentValue,
+		"current_gain":           p.CurrentGain,
+		"current_value_assets":   p.CurrentValueAssets,
+		"current_gain_percent":   p.CurrentGainPercent,
+		"one_day_gain":           p.OneDayGain,
+		"one_day_gain_percent":   p.OneDayGainPercent,
+		"invested":               p.Invested,
+		"invested_value_assets":  p.InvestedValueAssets,
+		"current_xirr":           p.CurrentXIRR,
+		"alltime_xirr":           p.AlltimeXIRR,
+		"alltime_return":         p.AlltimeReturn,
+		"alltime_abs_percentage": p.AlltimeAbsPercentage,
+		"alltime_abs_return":     p.AlltimeAbsReturn,
+
+		"gold.one_day_change":          p.Gold.OneDayChange,
+		"gold.current_value":           p.Gold.CurrentValue,
+		"gold.total_invested":          p.Gold.TotalInvested,
+		"gold.total_gold_quantity":     p.Gold.TotalGoldQuantity,
+		"gold.kuvera.quantity":         p.Gold.Kuvera.Quantity,
+		"gold.kuvera.one_day_change":   p.Gold.Kuvera.OneDayChange,
+		"gold.kuvera.invested_value":   p.Gold.Kuvera.InvestedValue,
+		"gold.kuvera.current_value":    p.Gold.Kuvera.CurrentValue,
+		"gold.kuvera.profit_amount":    p.Gold.Kuvera.ProfitAmount,
+		"gold.imported.quantity":       p.Gold.Imported.Quantity,
+		"gold.imported.one_day_change": p.Gold.Imported.OneDayChange,
+		"gold.imported.invested_value": p.Gold.Imported.InvestedValue,
+		"gold.imported.current_value":  p.Gold.Imported.CurrentValue,
+		"gold.imported.profit_amount":  p.Gold.Imported.ProfitAmount,
+		"gold.imported.xirr":           p.Gold.Imported.XIRR,
+
+		"indian_equities.one_day_change":            p.IndianEquities.OneDayChange,
+		"indian_equities.current_value":             p.IndianEquities.CurrentValue,
+		"indian_equities.total_invested":            p.IndianEquities.TotalInvested,
+		"indian_equities.one_day_change_percentage": p.IndianEquities.OneDayChangePercentage,
+
+		"mutual_funds.one_day_change":      p.MutualFunds.OneDayChange,
+		"mutual_funds.current_value":       p.MutualFunds.CurrentValue,
+		"mutual_funds.total_invested":      p.MutualFunds.TotalInvested,
+		"mutual_funds.xirr_percentage":     p.MutualFunds.XIRRPercentage,
+		"mutual_funds.absolute_percentage": p.MutualFunds.AbsolutePercentage,
+
+		"fixed_deposit.current_value":  p.FixedDeposit.CurrentValue,
+		"fixed_deposit.total_invested": p.FixedDeposit.TotalInvested.Float64(),
+		"fixed_deposit.one_day_change": p.FixedDeposit.OneDayChange,
+		"fixed_deposit.xirr":           p.FixedDeposit.XIRR,
+		"fixed_deposit.current_xirr":   p.FixedDeposit.CurrentXIRR,
+	}
+
+	setParsed(m, "gold.xirr", p.Gold.XIRR)
+	setParsed(m, "gold.kuvera.xirr", p.Gold.Kuvera.XIRR)
+
+	if p.USEquities != (USEquitiesData{}) {
+		m["us_equities.current_value"] = p.USEquities.CurrentValue
+		m["us_equities.total_invested"] = p.USEquities.TotalInvested
+		m["us_equities.one_day_change"] = p.USEquities.OneDayChange
+	}
+	if p.EPF != (EPFData{}) {
+		m["epf.current_value"] = p.EPF.CurrentValue
+		m["epf.total_invested"] = p.EPF.TotalInvested
+	}
+	if p.SaveSmarts != (SaveSmartsData{}) {
+		m["save_smarts.current_value"] = p.SaveSmarts.CurrentValue
+		m["save_smarts.total_invested"] = p.SaveSmarts.TotalInvested
+		m["save_smarts.one_day_change"] = p.SaveSmarts.OneDayChange
+	}
+
+	return m
+}
+
+// setParsed stores the float value of s under key, skipping empty or unparseable strings.
+func setParsed(m map[string]float64, key, s string) {
+	if v, err := strconv.ParseFloat(strings.TrimSpace(s), 64); err == nil {
+		m[key] = v
+	}
+}
+
+// Allocation returns each asset class's share of the total current value as a
+// percentage, keyed by the JSON field name ("mutual_funds", "gold",
+// "indian_equities" and "fixed_deposit").
+//
+// Asset classes with no current value are omitted. The shares are not rounded,
+// so their sum may differ from 100 by floating-point error. An empty map is
+// returned when the total current value is zero.
+func (p PortfolioData) Allocation() map[string]float64 {
+	values := map[string]float64{
+		"mutual_funds":    p.MutualFunds.CurrentValue,
+		"gold":            p.Gold.CurrentValue,
+		"indian_equities": p.IndianEquities.CurrentValue,
+		"fixed_deposit":   p.FixedDeposit.CurrentValue,
+	}
+
+	var total float64
+	for _, v := range values {
+		total += v
+	}
+
+	allocation := make(map[string]float64)
+	if total == 0 {
+		return allocation
+	}
+	for assetClass, v := range values {
+		if v != 0 {
+			allocation[assetClass] = v / total * 100
+		}
+	}
+	return allocation
+}
+
+// reconcileTolerance is the largest difference, in INR, that Reconcile treats
+// as floating-point noise rather than a discrepancy.
+const reconcileTolerance = 0.01
+
+// ReconcileResult compares the portfolio's top-level totals with the sum of its
+// asset classes.
+type ReconcileResult struct {
+	// Invested is the top-level amount invested
+	Invested float64
+	// ComponentsInvested is the sum of the asset classes' invested amounts
+	ComponentsInvested float64
+	// InvestedDiscrepancy is Invested minus ComponentsInvested
+	InvestedDiscrepancy float64
+	// CurrentValue is the top-level current value
+	CurrentValue float64
+	// ComponentsCurrentValue is the sum of the asset classes' current values
+	ComponentsCurrentValue float64
+	// CurrentValueDiscrepancy is CurrentValue minus ComponentsCurrentValue
+	CurrentValueDiscrepancy float64
+	// Matches indicates both discrepancies are within a paisa
+	Matches bool
+}
+
+// Reconcile sums the invested and current values of mutual funds, gold, Indian
+// equities and fixed deposits and compares them against the top-level Invested
+// and CurrentValue, to catch Kuvera's summary drifting from its components.
+//
+// An error is returned if any of the figures is not a finite number.
+func (p PortfolioData) Reconcile() (ReconcileResult, error) {
+	r := ReconcileResult{
+		Invested:     p.Invested,
+		CurrentValue: p.CurrentValue,
+		ComponentsInvested: p.MutualFunds.TotalInvested + p.Gold.TotalInvested +
+			p.IndianEquities.TotalInvested + p.FixedDeposit.TotalInvested.Float64(),
+		ComponentsCurrentValue: p.MutualFunds.CurrentValue + p.Gold.CurrentValue +
+			p.IndianEquities.CurrentValue + p.FixedDeposit.CurrentValue,
+	}
+	for _, v := range []float64{r.Invested, r.CurrentValue, r.ComponentsInvested, r.ComponentsCurrentValue} {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return ReconcileResult{}, fmt.Errorf("cannot reconcile non-finite value %v", v)
+		}
+	}
+
+	r.InvestedDiscrepancy = r.Invested - r.ComponentsInvested
+	r.CurrentValueDiscrepancy = r.CurrentValue - r.ComponentsCurrentValue
+	r.Matches = math.Abs(r.InvestedDiscrepancy) <= reconcileTolerance &&
+		math.Abs(r.CurrentValueDiscrepancy) <= reconcileTolerance
+
+	return r, nil
+}
+
+// OneDayBreakdown splits the portfolio's one-day change by asset class and
+// compares the total with the top-level OneDayGain.
+type OneDayBreakdown struct {
+	// MutualFunds is the one-day change of mutual funds
+	MutualFunds float64
+	// Gold is the one-day change of Kuvera and imported gold
+	Gold float64
+	// IndianEquities is the one-day change of Indian equities
+	IndianEquities float64
+	// FixedDeposit is the one-day change of fixed deposits
+	FixedDeposit float64
+	// Total is the sum of the asset classes' one-day changes
+	Total float64
+	// Reported is the top-level OneDayGain
+	Reported float64
+	// Discrepancy is Reported minus Total
+	Discrepancy float64
+	// Matches indicates the discrepancy is within a paisa
+	Matches bool
+}
+
+// OneDayChangeBreakdown sums the one-day changes of mutual funds, gold, Indian
+// equities and fixed deposits and compares the total against the top-level
+// OneDayGain, like Reconcile does for the invested and current values.
+//
+// Gold is taken as the sum of its Kuvera and imported parts rather than its
+// own total, so that a stale gold summary shows up as a discrepancy. If any
+// figure is not a finite number, the breakdown does not match.
+func (p PortfolioData) OneDayChangeBreakdown() OneDayBreakdown {
+	b := OneDayBreakdown{
+		MutualFunds:    p.MutualFunds.OneDayChange,
+		Gold:           p.Gold.Kuvera.OneDayChange + p.Gold.Imported.OneDayChange,
+		IndianEquities: p.IndianEquities.OneDayChange,
+		FixedDeposit:   p.FixedDeposit.OneDayChange,
+		Reported:       p.OneDayGain,
+	}
+	b.Total = b.MutualFunds + b.Gold + b.IndianEquities + b.FixedDeposit
+	b.Discrepancy = b.Reported - b.Total
+	// A NaN discrepancy fails the comparison, and so never matches
+	b.Matches = math.Abs(b.Discrepancy) <= reconcileTolerance
+	return b
+}
+
+// Delta describes how a single figure changed between two portfolio snapshots.
+type Delta struct {
+	// Old is the value in the older snapshot
+	Old float64
+	// New is the value in the newer snapshot
+	New float64
+	// Change is the absolute change (New - Old)
+	Change float64
+	// ChangePercent is the change relative to the magnitude of Old, so that it
+	// is positive whenever New is greater, even for a negative Old such as a
+	// loss; zero when Old is zero
+	ChangePercent float64
+	// NewPosition indicates the value was zero in the older snapshot and is not
+	// anymore, in which case ChangePercent is not meaningful
+	NewPosition bool
+}
+
+// newDelta computes the delta from one value to another, avoiding division by zero.
+func newDelta(from, to float64) Delta {
+	d := Delta{Old: from, New: to, Change: to - from}
+	switch {
+	case from != 0:
+		d.ChangePercent = d.Change / math.Abs(from) * 100
+	case to != 0:
+		d.NewPosition = true
+	}
+	return d
+}
+
+// PortfolioDiff contains the changes between two portfolio snapshots.
+type PortfolioDiff struct {
+	// CurrentValue is the change in total portfolio value
+	CurrentValue Delta
+	// CurrentGain is the change in total gain
+	CurrentGain Delta
+	// Invested is the change in total amount invested
+	Invested Delta
+	// MutualFunds is the change in mutual funds value
+	MutualFunds Delta
+	// Gold is the change in gold value
+	Gold Delta
+	// IndianEquities is the change in Indian equities value
+	IndianEquities Delta
+	// FixedDeposit is the change in fixed deposits value
+	FixedDeposit Delta
+}
+
+// DiffPortfolio compares two portfolio snapshots, such as consecutive daily
+// captures, and returns the change in overall value, gain, and each asset class.
+//
+// A nil snapshot is treated as an empty portfolio. Asset classes that were zero
+// in the old snapshot are reported with NewPosition set instead of a percentage.
+func DiffPortfolio(old, latest *PortfolioData) PortfolioDiff {
+	if old == nil {
+		old = &PortfolioData{}
+	}
+	if latest == nil {
+		latest = &PortfolioData{}
+	}
+
+	return PortfolioDiff{
+		CurrentValue:   newDelta(old.CurrentValue, latest.CurrentValue),
+		CurrentGain:    newDelta(old.CurrentGain, latest.CurrentGain),
+		Invested:       newDelta(old.Invested, latest.Invested),
+		MutualFunds:    newDelta(old.MutualFunds.CurrentValue, latest.MutualFunds.CurrentValue),
+		Gold:           newDelta(old.Gold.CurrentValue, latest.Gold.CurrentValue),
+		IndianEquities: newDelta(old.IndianEquities.CurrentValue, latest.IndianEquities.CurrentValue),
+		FixedDeposit:   newDelta(old.FixedDeposit.CurrentValue, latest.FixedDeposit.CurrentValue),
+	}
+}
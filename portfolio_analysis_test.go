@@ -0,0 +1,289 @@
+package kuvera
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestPortfolioDataMetrics(t *testing.T) {
+	body := []byte(`{
+		"current_value": 150000, "current_gain_percent": 12.5, "invested": 133333,
+		"us_equities": {}, "epf": {}, "save_smarts": {},
+		"gold": {"current_value": 20000, "xirr": "8.25", "kuvera": {"xirr": "not available"}},
+		"mutual_funds": {"current_value": 110000, "xirr_percentage": 14.2},
+		"fixed_deposit": {"current_value": 20000, "total_invested": "19000.50"}
+	}`)
+
+	var portfolio PortfolioData
+	if err := json.Unmarshal(body, &portfolio); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	metrics := portfolio.Metrics()
+
+	wantValues := map[string]float64{
+		"current_value":                150000,
+		"current_gain_percent":         12.5,
+		"mutual_funds.current_value":   110000,
+		"gold.xirr":                    8.25,
+		"fixed_deposit.total_invested": 19000.50,
+	}
+	for key, want := range wantValues {
+		if got, ok := metrics[key]; !ok || got != want {
+			t.Errorf("metrics[%q] = %v (present: %t), want %v", key, got, ok, want)
+		}
+	}
+
+	if _, ok := metrics["gold.kuvera.xirr"]; ok {
+		t.Error("unparseable gold.kuvera.xirr should be omitted")
+	}
+	for key := range metrics {
+		for _, prefix := range []string{"us_equities", "epf", "save_smarts"} {
+			if strings.HasPrefix(key, prefix) {
+				t.Errorf("empty %s object contributed key %q", prefix, key)
+			}
+		}
+	}
+
+	wantKeys := []string{
+		"alltime_abs_percentage", "alltime_abs_return", "alltime_return", "alltime_xirr",
+		"current_gain", "current_gain_percent", "current_value", "current_value_assets", "current_xirr",
+		"fixed_deposit.current_value", "fixed_deposit.current_xirr", "fixed_deposit.one_day_change",
+		"fixed_deposit.total_invested", "fixed_deposit.xirr",
+		"gold.current_value",
+		"gold.imported.current_value", "gold.imported.invested_value", "gold.imported.one_day_change",
+		"gold.imported.profit_amount", "gold.imported.quantity", "gold.imported.xirr",
+		"gold.kuvera.current_value", "gold.kuvera.invested_value", "gold.kuvera.one_day_change",
+		"gold.kuvera.profit_amount", "gold.kuvera.quantity",
+		"gold.one_day_change", "gold.total_gold_quantity", "gold.total_invested", "gold.xirr",
+		"indian_equities.current_value", "indian_equities.one_day_change",
+		"indian_equities.one_day_change_percentage", "indian_equities.total_invested",
+		"invested", "invested_value_assets",
+		"mutual_funds.absolute_percentage", "mutual_funds.current_value", "mutual_funds.one_day_change",
+		"mutual_funds.total_invested", "mutual_funds.xirr_percentage",
+		"one_day_gain", "one_day_gain_percent",
+	}
+	var keys []string
+	for key := range metrics {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	if !reflect.DeepEqual(keys, wantKeys) {
+		t.Errorf("metric keys = %v, want %v", keys, wantKeys)
+	}
+}
+
+func TestPortfolioDataMetricsOptionalAssetClasses(t *testing.T) {
+	portfolio := PortfolioData{
+		USEquities: USEquitiesData{CurrentValue: 5000, TotalInvested: 4500},
+		EPF:        EPFData{CurrentValue: 250000},
+	}
+	metrics := portfolio.Metrics()
+
+	if got := metrics["us_equities.current_value"]; got != 5000 {
+		t.Errorf("us_equities.current_value = %v, want 5000", got)
+	}
+	if got, ok := metrics["us_equities.one_day_change"]; !ok || got != 0 {
+		t.Errorf("us_equities.one_day_change = %v (present: %t), want 0", got, ok)
+	}
+	if got := metrics["epf.current_value"]; got != 250000 {
+		t.Errorf("epf.current_value = %v, want 250000", got)
+	}
+	if _, ok := metrics["save_smarts.current_value"]; ok {
+		t.Error("empty save_smarts should be omitted")
+	}
+}
+
+func TestDiffPortfolio(t *testing.T) {
+	old := &PortfolioData{
+		CurrentValue: 100000,
+		CurrentGain:  10000,
+		Invested:     90000,
+		MutualFunds:  MutualFundsData{CurrentValue: 80000},
+		Gold:         GoldData{CurrentValue: 20000},
+	}
+	latest := &PortfolioData{
+		CurrentValue:   115000,
+		CurrentGain:    12000,
+		Invested:       100000,
+		MutualFunds:    MutualFundsData{CurrentValue: 100000},
+		Gold:           GoldData{CurrentValue: 5000},
+		IndianEquities: IndianEquitiesData{CurrentValue: 10000},
+	}
+
+	diff := DiffPortfolio(old, latest)
+
+	tests := []struct {
+		name          string
+		delta         Delta
+		change        float64
+		changePercent float64
+		newPosition   bool
+	}{
+		{"growth", diff.MutualFunds, 20000, 25, false},
+		{"decline", diff.Gold, -15000, -75, false},
+		{"new asset class", diff.IndianEquities, 10000, 0, true},
+		{"unchanged", diff.FixedDeposit, 0, 0, false},
+		{"current value", diff.CurrentValue, 15000, 15, false},
+		{"current gain", diff.CurrentGain, 2000, 20, false},
+	}
+
+	for _, tt := range tests {
+		if tt.delta.Change != tt.change || tt.delta.ChangePercent != tt.changePercent || tt.delta.NewPosition != tt.newPosition {
+			t.Errorf("%s: got %+v, want change %v, percent %v, new position %t",
+				tt.name, tt.delta, tt.change, tt.changePercent, tt.newPosition)
+		}
+	}
+}
+
+func TestDiffPortfolioNegativeBase(t *testing.T) {
+	diff := DiffPortfolio(&PortfolioData{CurrentGain: -100}, &PortfolioData{CurrentGain: -50})
+	if diff.CurrentGain.Change != 50 || diff.CurrentGain.ChangePercent != 50 {
+		t.Errorf("CurrentGain = %+v, want change 50, percent 50", diff.CurrentGain)
+	}
+
+	diff = DiffPortfolio(&PortfolioData{CurrentGain: -100}, &PortfolioData{CurrentGain: -150})
+	if diff.CurrentGain.ChangePercent != -50 {
+		t.Errorf("CurrentGain = %+v, want percent -50", diff.CurrentGain)
+	}
+}
+
+func TestDiffPortfolioNil(t *testing.T) {
+	diff := DiffPortfolio(nil, &PortfolioData{CurrentValue: 500})
+	if !diff.CurrentValue.NewPosition || diff.CurrentValue.Change != 500 {
+		t.Errorf("CurrentValue = %+v, want a new position of 500", diff.CurrentValue)
+	}
+}
+
+func TestPortfolioDataAllocation(t *testing.T) {
+	p := PortfolioData{
+		MutualFunds:    MutualFundsData{CurrentValue: 60000},
+		Gold:           GoldData{CurrentValue: 10000},
+		IndianEquities: IndianEquitiesData{CurrentValue: 30000},
+	}
+
+	allocation := p.Allocation()
+
+	want := map[string]float64{"mutual_funds": 60, "gold": 10, "indian_equities": 30}
+	if len(allocation) != len(want) {
+		t.Fatalf("Allocation() = %v, want %v", allocation, want)
+	}
+	var sum float64
+	for assetClass, share := range want {
+		if got := allocation[assetClass]; math.Abs(got-share) > 1e-9 {
+			t.Errorf("allocation[%q] = %v, want %v", assetClass, got, share)
+		}
+		sum += allocation[assetClass]
+	}
+	if math.Abs(sum-100) > 1e-9 {
+		t.Errorf("shares sum to %v, want 100", sum)
+	}
+	if _, ok := allocation["fixed_deposit"]; ok {
+		t.Error("empty fixed_deposit should be omitted")
+	}
+}
+
+func TestPortfolioDataAllocationZeroTotal(t *testing.T) {
+	allocation := PortfolioData{}.Allocation()
+	if allocation == nil || len(allocation) != 0 {
+		t.Errorf("Allocation() = %#v, want an empty map", allocation)
+	}
+}
+
+func reconcilablePortfolio() PortfolioData {
+	return PortfolioData{
+		Invested:       90000.3,
+		CurrentValue:   105000.6,
+		MutualFunds:    MutualFundsData{TotalInvested: 60000.1, CurrentValue: 70000.2},
+		Gold:           GoldData{TotalInvested: 10000.1, CurrentValue: 12000.2},
+		IndianEquities: IndianEquitiesData{TotalInvested: 15000.1, CurrentValue: 17000.2},
+		FixedDeposit:   FixedDepositData{TotalInvested: 5000, CurrentValue: 6000},
+	}
+}
+
+func TestPortfolioDataReconcile(t *testing.T) {
+	r, err := reconcilablePortfolio().Reconcile()
+	if err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if !r.Matches {
+		t.Errorf("Reconcile() = %+v, want a match", r)
+	}
+	if math.Abs(r.ComponentsInvested-90000.3) > 1e-6 || math.Abs(r.ComponentsCurrentValue-105000.6) > 1e-6 {
+		t.Errorf("unexpected component totals: %+v", r)
+	}
+}
+
+func TestPortfolioDataReconcileDiscrepancy(t *testing.T) {
+	p := reconcilablePortfolio()
+	p.CurrentValue += 250
+
+	r, err := p.Reconcile()
+	if err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if r.Matches {
+		t.Errorf("Reconcile() = %+v, want a mismatch", r)
+	}
+	if math.Abs(r.CurrentValueDiscrepancy-250) > 1e-6 {
+		t.Errorf("CurrentValueDiscrepancy = %v, want 250", r.CurrentValueDiscrepancy)
+	}
+	if math.Abs(r.InvestedDiscrepancy) > reconcileTolerance {
+		t.Errorf("InvestedDiscrepancy = %v, want 0", r.InvestedDiscrepancy)
+	}
+}
+
+func oneDayPortfolio() PortfolioData {
+	return PortfolioData{
+		OneDayGain:  1234.5,
+		MutualFunds: MutualFundsData{OneDayChange: 1000.1},
+		Gold: GoldData{
+			OneDayChange: 999, // ignored in favour of its parts
+			Kuvera:       GoldKuveraData{OneDayChange: 150.2},
+			Imported:     GoldImportedData{OneDayChange: 50.1},
+		},
+		IndianEquities: IndianEquitiesData{OneDayChange: -16.1},
+		FixedDeposit:   FixedDepositData{OneDayChange: 50.2},
+	}
+}
+
+func TestPortfolioDataOneDayChangeBreakdown(t *testing.T) {
+	b := oneDayPortfolio().OneDayChangeBreakdown()
+	if !b.Matches {
+		t.Errorf("OneDayChangeBreakdown() = %+v, want a match", b)
+	}
+	if math.Abs(b.Gold-200.3) > 1e-6 || math.Abs(b.Total-1234.5) > 1e-6 || b.Reported != 1234.5 {
+		t.Errorf("unexpected breakdown: %+v", b)
+	}
+}
+
+func TestPortfolioDataOneDayChangeBreakdownDiscrepancy(t *testing.T) {
+	p := oneDayPortfolio()
+	p.OneDayGain -= 100
+
+	b := p.OneDayChangeBreakdown()
+	if b.Matches {
+		t.Errorf("OneDayChangeBreakdown() = %+v, want a mismatch", b)
+	}
+	if math.Abs(b.Discrepancy+100) > 1e-6 {
+		t.Errorf("Discrepancy = %v, want -100", b.Discrepancy)
+	}
+
+	p = oneDayPortfolio()
+	p.FixedDeposit.OneDayChange = math.NaN()
+	if b := p.OneDayChangeBreakdown(); b.Matches {
+		t.Errorf("OneDayChangeBreakdown() with NaN = %+v, want a mismatch", b)
+	}
+}
+
+func TestPortfolioDataReconcileNonFinite(t *testing.T) {
+	p := reconcilablePortfolio()
+	p.FixedDeposit.TotalInvested = FlexFloat(math.NaN())
+
+	if _, err := p.Reconcile(); err == nil {
+		t.Error("Reconcile() error = nil, want error")
+	}
+}
@@ -0,0 +1,77 @@
+package kuvera
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestGetPortfolioAsOfFutureDate(t *testing.T) {
+	clk := newFakeClock()
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request expected for a future date")
+	}, withClock(clk))
+
+	_, err := client.GetPortfolioAsOf(context.Background(), clk.Now().Add(24*time.Hour))
+	if !errors.Is(err, ErrFutureDate) {
+		t.Errorf("GetPortfolioAsOf() error = %v, want %v", err, ErrFutureDate)
+	}
+}
+
+func TestGetPortfolioAsOf(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v5/portfolio/returns.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("date"); got != "2023-03-31" {
+			t.Errorf("date = %q, want %q", got, "2023-03-31")
+		}
+		w.Write([]byte(`{"status":"success","data":{"current_value":98000.25,"invested":90000,"mutual_funds":{"current_value":98000.25}}}`))
+	}, withClock(newFakeClock()))
+
+	date := time.Date(2023, 3, 31, 0, 0, 0, 0, time.UTC)
+	portfolio, err := client.GetPortfolioAsOf(context.Background(), date)
+	if err != nil {
+		t.Fatalf("GetPortfolioAsOf() error = %v", err)
+	}
+	if portfolio.Data.CurrentValue != 98000.25 || portfolio.Data.Invested != 90000 {
+		t.Errorf("unexpected portfolio data: %+v", portfolio.Data)
+	}
+}
+
+func TestGetPortfolioAsOfNoData(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":"success","data":{}}`))
+	}, withClock(newFakeClock()))
+
+	date := time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC)
+	if _, err := client.GetPortfolioAsOf(context.Background(), date); !errors.Is(err, ErrNoDataForDate) {
+		t.Errorf("GetPortfolioAsOf() error = %v, want %v", err, ErrNoDataForDate)
+	}
+}
+
+func TestGetPortfolioForAccount(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v5/portfolio/returns.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("account_id"); got != "FAM 123" {
+			t.Errorf("account_id = %q, want %q", got, "FAM 123")
+		}
+		w.Write([]byte(`{"status":"success","data":{"current_value":5000}}`))
+	})
+
+	portfolio, err := client.GetPortfolioForAccount(context.Background(), "FAM 123")
+	if err != nil {
+		t.Fatalf("GetPortfolioForAccount() error = %v", err)
+	}
+	if portfolio.Data.CurrentValue != 5000 {
+		t.Errorf("CurrentValue = %v, want 5000", portfolio.Data.CurrentValue)
+	}
+
+	if _, err := client.GetPortfolioForAccount(context.Background(), " "); !errors.Is(err, ErrEmptyAccountID) {
+		t.Errorf("GetPortfolioForAccount() error = %v, want %v", err, ErrEmptyAccountID)
+	}
+}
@@ -0,0 +1,72 @@
+package kuvera
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// WithRateLimit throttles outbound requests to requestsPerSecond, allowing
+// bursts of up to burst requests.
+//
+// The limiter is shared across all methods on the client and across every
+// goroutine using it, so concurrent callers are smoothed out together. Waiting
+// for a token respects context cancellation. A non-positive rate disables limiting.
+func WithRateLimit(requestsPerSecond float64, burst int) ClientOption {
+	return func(c *clientConfig) {
+		c.rateLimit = requestsPerSecond
+		c.rateBurst = burst
+		c.limiter = nil
+	}
+}
+
+// rateLimiter is a token bucket limiter safe for concurrent use.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	clock  clock
+}
+
+// newRateLimiter returns a limiter with a full bucket of burst tokens.
+func newRateLimiter(requestsPerSecond float64, burst int, clk clock) *rateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimiter{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   clk.Now(),
+		clock:  clk,
+	}
+}
+
+// Wait blocks until a token is available or ctx is done.
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := l.clock.Now()
+	l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	// Reserve the token up front so concurrent waiters queue behind each other
+	l.tokens--
+	delay := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	select {
+	case <-l.clock.After(delay):
+		return nil
+	case <-ctx.Done():
+		// Return the unused reservation
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return ctx.Err()
+	}
+}
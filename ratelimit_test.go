@@ -0,0 +1,70 @@
+package kuvera
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestWithRateLimit(t *testing.T) {
+	const (
+		requests = 5
+		rate     = 20.0
+	)
+
+	clk := newFakeClock()
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}, WithRateLimit(rate, 1), withClock(clk))
+
+	start := clk.Now()
+	for i := 0; i < requests; i++ {
+		if err := client.Ping(context.Background()); err != nil {
+			t.Fatalf("Ping() error = %v", err)
+		}
+	}
+	waited := clk.Now().Sub(start)
+
+	// The first request uses the burst token; each later one waits 1/rate
+	want := time.Duration(float64(requests-1) / rate * float64(time.Second))
+	if diff := waited - want; diff < -time.Millisecond || diff > time.Millisecond {
+		t.Errorf("%d requests waited %v, want %v", requests, waited, want)
+	}
+}
+
+func TestWithRateLimitSharedWithClone(t *testing.T) {
+	client := NewClient(WithRateLimit(1, 1)).(*Client)
+	if client.limiter == nil {
+		t.Fatal("limiter = nil, want one")
+	}
+	if clone := client.Clone().(*Client); clone.limiter != client.limiter {
+		t.Error("clone has its own limiter, want the shared one")
+	}
+	if clone := client.Clone(WithRateLimit(0, 1)).(*Client); clone.limiter != nil {
+		t.Error("clone with a zero rate has a limiter, want none")
+	}
+}
+
+func TestWithRateLimitContextCancel(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}, WithRateLimit(0.1, 1))
+
+	if err := client.Ping(context.Background()); err != nil {
+		t.Fatalf("Ping() error = %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := client.Ping(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Ping() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Ping() waited %v despite cancelled context", elapsed)
+	}
+}
@@ -0,0 +1,54 @@
+package kuvera
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrTooManyRedirects is returned when a request is redirected more times than
+// allowed by WithRedirectPolicy.
+var ErrTooManyRedirects = errors.New("too many redirects")
+
+// redirectPolicy holds the settings of WithRedirectPolicy.
+type redirectPolicy struct {
+	max                  int
+	stripAuthOnCrossHost bool
+}
+
+// WithRedirectPolicy controls how redirects are followed. At most max
+// redirects are followed per request; one more fails the request with
+// ErrTooManyRedirects, and a max of zero or less refuses all redirects, which
+// makes a moved endpoint show up as an error rather than go unnoticed. If
+// stripAuthOnCrossHost is true, the Authorization header is dropped once a
+// redirect leads to a host other than the original one, including the same
+// host on another port.
+//
+// By default the net/http policy applies: up to 10 redirects, with the
+// Authorization header only dropped for redirects to an unrelated domain. The
+// policy replaces the CheckRedirect of a client set with WithHTTPClient,
+// regardless of option order; that client is copied rather than modified.
+func WithRedirectPolicy(max int, stripAuthOnCrossHost bool) ClientOption {
+	return func(c *clientConfig) {
+		c.redirectPolicy = &redirectPolicy{max: max, stripAuthOnCrossHost: stripAuthOnCrossHost}
+	}
+}
+
+// applyRedirectPolicy replaces the configured HTTP client with a copy that
+// follows redirects according to the configured policy.
+func (c *clientConfig) applyRedirectPolicy() {
+	policy := *c.redirectPolicy
+	httpClient := *c.httpClient
+	httpClient.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if len(via) > policy.max {
+			return fmt.Errorf("%w: stopped after %d", ErrTooManyRedirects, policy.max)
+		}
+		// Headers are copied from the original request on every redirect, so
+		// the host is compared against it rather than the previous hop
+		if policy.stripAuthOnCrossHost && req.URL.Host != via[0].URL.Host {
+			req.Header.Del("Authorization")
+		}
+		return nil
+	}
+	c.httpClient = &httpClient
+}
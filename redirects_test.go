@@ -0,0 +1,51 @@
+package kuvera
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWithRedirectPolicyStripsAuthOnCrossHost(t *testing.T) {
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "" {
+			t.Errorf("Authorization = %q on the other host, want none", got)
+		}
+		w.Write([]byte(`{"status":"success"}`))
+	}))
+	defer other.Close()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/moved":
+			http.Redirect(w, r, other.URL+"/elsewhere", http.StatusFound)
+		default:
+			if got := r.Header.Get("Authorization"); got != "Bearer test-token" {
+				t.Errorf("Authorization = %q on the same host, want the token", got)
+			}
+			http.Redirect(w, r, "/moved", http.StatusFound)
+		}
+	}, WithRedirectPolicy(2, true))
+
+	if _, err := client.GetPortfolio(context.Background()); err != nil {
+		t.Fatalf("GetPortfolio() error = %v", err)
+	}
+}
+
+func TestWithRedirectPolicyLimit(t *testing.T) {
+	var requests int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		http.Redirect(w, r, "/loop", http.StatusFound)
+	}, WithRedirectPolicy(3, false), WithRetry(2, 0))
+
+	_, err := client.GetPortfolio(context.Background())
+	if !errors.Is(err, ErrTooManyRedirects) {
+		t.Errorf("GetPortfolio() error = %v, want %v", err, ErrTooManyRedirects)
+	}
+	if requests != 4 {
+		t.Errorf("requests = %d, want 4 (no retries)", requests)
+	}
+}
@@ -0,0 +1,426 @@
+package kuvera
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrReplayUnsupported is returned by ReplayClient for operations that would
+// change state on Kuvera, which a replay cannot do.
+var ErrReplayUnsupported = errors.New("operation not supported by replay client")
+
+// PortfolioFromJSON decodes a saved portfolio response body, as returned by the
+// portfolio endpoint.
+func PortfolioFromJSON(r io.Reader) (*PortfolioResponse, error) {
+	var portfolioResp PortfolioResponse
+	if err := decodeSaved(r, &portfolioResp, "portfolio"); err != nil {
+		return nil, err
+	}
+	return &portfolioResp, nil
+}
+
+// HoldingsFromJSON decodes a saved holdings response body, as returned by the
+// holdings endpoint.
+func HoldingsFromJSON(r io.Reader) (*HoldingsResponse, error) {
+	var holdingsResp HoldingsResponse
+	if err := decodeSaved(r, &holdingsResp, "holdings"); err != nil {
+		return nil, err
+	}
+	return &holdingsResp, nil
+}
+
+// GoldPriceFromJSON decodes a saved gold price response body, as returned by
+// the gold price endpoint.
+func GoldPriceFromJSON(r io.Reader) (*GoldPriceResponse, error) {
+	var goldResp GoldPriceResponse
+	if err := decodeSaved(r, &goldResp, "gold price"); err != nil {
+		return nil, err
+	}
+	return &goldResp, nil
+}
+
+// SnapshotFromJSON decodes a snapshot written by Snapshot.WriteJSON.
+func SnapshotFromJSON(r io.Reader) (*Snapshot, error) {
+	var snapshot Snapshot
+	if err := decodeSaved(r, &snapshot, "snapshot"); err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+// decodeSaved decodes a single saved JSON document from r into v.
+func decodeSaved(r io.Reader, v interface{}, what string) error {
+	if err := json.NewDecoder(r).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode saved %s: %w", what, err)
+	}
+	return nil
+}
+
+// ReplayClient implements KuveraClient by serving saved response bodies from a
+// directory instead of calling the API, for offline demos and deterministic
+// tests of code that consumes the client.
+//
+// Each method reads one file from the directory:
+//
+//	Login                   login.json
+//	GetPortfolio            portfolio.json
+//	GetPortfolioAsOf        portfolio_<YYYY-MM-DD>.json
+//	GetPortfolioForAccount  portfolio_account_<accountID>.json
+//	GetHoldings             holdings.json
+//	GetGoldPrice            gold_price.json
+//	GetGoals                goals.json
+//	GetCapitalGains         capital_gains_<financialYear>.json
+//	GetWatchlist            watchlist.json
+//	GetFundDetails          fund_<fundCode>.json (a list, as the API returns it)
+//	GetDividends            dividends.json
+//	GetEquityHoldings       equities.json
+//	GetAll                  snapshot.json (as written by Snapshot.WriteJSON)
+//	GetNAVs                 navs_<YYYY-MM-DD>.json (an object of NAVs by fund code)
+//	GetLinkedAccounts       linked_accounts.json
+//	GetPortfolioHistory     portfolio_history_<granularity>.json (filtered to the range)
+//	GetAvailableFunds       available_funds_<page>.json (the first page is 1)
+//	GetEPFDetails           epf.json
+//	GetFundNAVHistory       nav_history_<fundCode>.json (filtered to the range)
+//	GetOrderStatus          order_status_<refNo>.json
+//
+// A missing file results in an error wrapping fs.ErrNotExist. Methods that
+// would change state, such as AddToWatchlist or BuyGold, return
+// ErrReplayUnsupported. Ping succeeds if the directory exists.
+type ReplayClient struct {
+	dir string
+}
+
+// Ensure ReplayClient satisfies the client interface.
+var _ KuveraClient = (*ReplayClient)(nil)
+
+// NewReplayClient creates a ReplayClient serving saved responses from dir.
+func NewReplayClient(dir string) *ReplayClient {
+	return &ReplayClient{dir: dir}
+}
+
+// load decodes the saved file name into v.
+func (c *ReplayClient) load(name string, v interface{}) error {
+	f, err := os.Open(filepath.Join(c.dir, name))
+	if err != nil {
+		return fmt.Errorf("replay: %w", err)
+	}
+	defer f.Close()
+
+	return decodeSaved(f, v, strings.TrimSuffix(name, ".json"))
+}
+
+// replayFile returns the file name for a parameterised response. The parameter
+// is escaped so that it cannot refer to a file outside the directory.
+func replayFile(prefix, param string) string {
+	return prefix + "_" + url.PathEscape(param) + ".json"
+}
+
+// Login returns the saved login response. Like Client.Login, it returns
+// ErrInvalidCredentials along with the response if the saved login failed.
+func (c *ReplayClient) Login(ctx context.Context, username, password string) (*LoginResponse, error) {
+	if strings.TrimSpace(username) == "" {
+		return nil, ErrEmptyUsername
+	}
+	if strings.TrimSpace(password) == "" {
+		return nil, ErrEmptyPassword
+	}
+
+	var loginResp LoginResponse
+	if err := c.load("login.json", &loginResp); err != nil {
+		return nil, err
+	}
+	if !loginResp.IsSuccess() {
+		return &loginResp, ErrInvalidCredentials
+	}
+	return &loginResp, nil
+}
+
+// GetPortfolio returns the saved portfolio.
+func (c *ReplayClient) GetPortfolio(ctx context.Context) (*PortfolioResponse, error) {
+	var portfolioResp PortfolioResponse
+	if err := c.load("portfolio.json", &portfolioResp); err != nil {
+		return nil, err
+	}
+	return &portfolioResp, nil
+}
+
+// GetHoldings returns the saved holdings.
+func (c *ReplayClient) GetHoldings(ctx context.Context) (*HoldingsResponse, error) {
+	var holdingsResp HoldingsResponse
+	if err := c.load("holdings.json", &holdingsResp); err != nil {
+		return nil, err
+	}
+	return &holdingsResp, nil
+}
+
+// GetGoldPrice returns the saved gold price.
+func (c *ReplayClient) GetGoldPrice(ctx context.Context) (*GoldPriceResponse, error) {
+	var goldResp GoldPriceResponse
+	if err := c.load("gold_price.json", &goldResp); err != nil {
+		return nil, err
+	}
+	return &goldResp, nil
+}
+
+// GetGoals returns the saved goals.
+func (c *ReplayClient) GetGoals(ctx context.Context) (*GoalsResponse, error) {
+	var goalsResp GoalsResponse
+	if err := c.load("goals.json", &goalsResp); err != nil {
+		return nil, err
+	}
+	return &goalsResp, nil
+}
+
+// GetCapitalGains returns the saved capital gains for financialYear.
+func (c *ReplayClient) GetCapitalGains(ctx context.Context, financialYear string) (*CapitalGainsResponse, error) {
+	if err := validateFinancialYear(financialYear); err != nil {
+		return nil, fmt.Errorf("%w: %q", err, financialYear)
+	}
+
+	var gainsResp CapitalGainsResponse
+	if err := c.load(replayFile("capital_gains", financialYear), &gainsResp); err != nil {
+		return nil, err
+	}
+	return &gainsResp, nil
+}
+
+// GetPortfolioAsOf returns the saved portfolio for date.
+func (c *ReplayClient) GetPortfolioAsOf(ctx context.Context, date time.Time) (*PortfolioResponse, error) {
+	var portfolioResp PortfolioResponse
+	if err := c.load(replayFile("portfolio", date.Format(dateLayout)), &portfolioResp); err != nil {
+		return nil, err
+	}
+	return &portfolioResp, nil
+}
+
+// GetWatchlist returns the saved watchlist.
+func (c *ReplayClient) GetWatchlist(ctx context.Context) (*WatchlistResponse, error) {
+	var watchlistResp WatchlistResponse
+	if err := c.load("watchlist.json", &watchlistResp); err != nil {
+		return nil, err
+	}
+	return &watchlistResp, nil
+}
+
+// AddToWatchlist returns ErrReplayUnsupported.
+func (c *ReplayClient) AddToWatchlist(ctx context.Context, fundCode string) error {
+	return ErrReplayUnsupported
+}
+
+// RemoveFromWatchlist returns ErrReplayUnsupported.
+func (c *ReplayClient) RemoveFromWatchlist(ctx context.Context, fundCode string) error {
+	return ErrReplayUnsupported
+}
+
+// BuyGold returns ErrReplayUnsupported.
+func (c *ReplayClient) BuyGold(ctx context.Context, amount float64) (*GoldOrderResponse, error) {
+	return nil, ErrReplayUnsupported
+}
+
+// SellGold returns ErrReplayUnsupported.
+func (c *ReplayClient) SellGold(ctx context.Context, grams float64) (*GoldOrderResponse, error) {
+	return nil, ErrReplayUnsupported
+}
+
+// GetFundDetails returns the saved details of fundCode.
+func (c *ReplayClient) GetFundDetails(ctx context.Context, fundCode string) (*FundDetails, error) {
+	if strings.TrimSpace(fundCode) == "" {
+		return nil, ErrEmptyFundCode
+	}
+
+	// Saved bodies are lists holding the requested scheme, as the API returns them
+	var funds []FundDetails
+	if err := c.load(replayFile("fund", fundCode), &funds); err != nil {
+		return nil, err
+	}
+	if len(funds) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrFundNotFound, fundCode)
+	}
+	return &funds[0], nil
+}
+
+// GetDividends returns the saved dividend history.
+func (c *ReplayClient) GetDividends(ctx context.Context) (*DividendsResponse, error) {
+	var dividendsResp DividendsResponse
+	if err := c.load("dividends.json", &dividendsResp); err != nil {
+		return nil, err
+	}
+	return &dividendsResp, nil
+}
+
+// GetPortfolioForAccount returns the saved portfolio of accountID.
+func (c *ReplayClient) GetPortfolioForAccount(ctx context.Context, accountID string) (*PortfolioResponse, error) {
+	if strings.TrimSpace(accountID) == "" {
+		return nil, ErrEmptyAccountID
+	}
+
+	var portfolioResp PortfolioResponse
+	if err := c.load(replayFile("portfolio_account", accountID), &portfolioResp); err != nil {
+		return nil, err
+	}
+	return &portfolioResp, nil
+}
+
+// CreateSIP returns ErrReplayUnsupported.
+func (c *ReplayClient) CreateSIP(ctx context.Context, req SIPCreateRequest) (*SIPDetail, error) {
+	return nil, ErrReplayUnsupported
+}
+
+// CancelSIP returns ErrReplayUnsupported.
+func (c *ReplayClient) CancelSIP(ctx context.Context, sipID int) error {
+	return ErrReplayUnsupported
+}
+
+// GetEquityHoldings returns the saved equity holdings.
+func (c *ReplayClient) GetEquityHoldings(ctx context.Context) (*EquityHoldingsResponse, error) {
+	var equitiesResp EquityHoldingsResponse
+	if err := c.load("equities.json", &equitiesResp); err != nil {
+		return nil, err
+	}
+	if equitiesResp.Data == nil {
+		equitiesResp.Data = []EquityHolding{}
+	}
+	return &equitiesResp, nil
+}
+
+// GetAll returns the saved snapshot.
+func (c *ReplayClient) GetAll(ctx context.Context) (*Snapshot, error) {
+	var snapshot Snapshot
+	if err := c.load("snapshot.json", &snapshot); err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+// GetNAVs returns the saved NAVs of fundCodes on date. Funds missing from the
+// saved file fail with ErrFundNotFound, as with Client.GetNAVs.
+func (c *ReplayClient) GetNAVs(ctx context.Context, fundCodes []string, date time.Time) (map[string]float64, error) {
+	var saved map[string]float64
+	if err := c.load(replayFile("navs", date.Format(dateLayout)), &saved); err != nil {
+		return nil, err
+	}
+
+	navs := make(map[string]float64)
+	var errs []error
+	for _, code := range uniqueFundCodes(fundCodes) {
+		nav, ok := saved[code]
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: %w", code, ErrFundNotFound))
+			continue
+		}
+		navs[code] = nav
+	}
+	return navs, errors.Join(errs...)
+}
+
+// GetLinkedAccounts returns the saved linked accounts.
+func (c *ReplayClient) GetLinkedAccounts(ctx context.Context) (*LinkedAccountsResponse, error) {
+	var accountsResp LinkedAccountsResponse
+	if err := c.load("linked_accounts.json", &accountsResp); err != nil {
+		return nil, err
+	}
+	if accountsResp.Data == nil {
+		accountsResp.Data = []LinkedAccount{}
+	}
+	return &accountsResp, nil
+}
+
+// Ping reports whether the replay directory exists.
+func (c *ReplayClient) Ping(ctx context.Context) error {
+	info, err := os.Stat(c.dir)
+	if err != nil {
+		return fmt.Errorf("replay: %w", err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("replay: %s is not a directory", c.dir)
+	}
+	return nil
+}
+
+// GetPortfolioHistory returns the points of the saved history for granularity
+// that fall between from and to.
+func (c *ReplayClient) GetPortfolioHistory(ctx context.Context, from, to time.Time, granularity string) (*PortfolioHistoryResponse, error) {
+	var historyResp PortfolioHistoryResponse
+	if err := c.load(replayFile("portfolio_history", granularity), &historyResp); err != nil {
+		return nil, err
+	}
+
+	first, last := calendarDate(from).Format(dateLayout), calendarDate(to).Format(dateLayout)
+	points := make([]PortfolioHistoryPoint, 0, len(historyResp.Data))
+	for _, point := range historyResp.Data {
+		if point.Date >= first && point.Date <= last {
+			points = append(points, point)
+		}
+	}
+	historyResp.Data = points
+	return &historyResp, nil
+}
+
+// ImportCAS returns ErrReplayUnsupported.
+func (c *ReplayClient) ImportCAS(ctx context.Context, casData []byte, password string) (*CASImportResponse, error) {
+	return nil, ErrReplayUnsupported
+}
+
+// GetAvailableFunds returns the saved page of the fund catalogue. The filter's
+// category, fund house and plan are not applied.
+func (c *ReplayClient) GetAvailableFunds(ctx context.Context, filter FundFilter) (*FundListResponse, error) {
+	var fundsResp FundListResponse
+	if err := c.load(replayFile("available_funds", strconv.Itoa(max(filter.Page, 1))), &fundsResp); err != nil {
+		return nil, err
+	}
+	if fundsResp.Data == nil {
+		fundsResp.Data = []AvailableFund{}
+	}
+	return &fundsResp, nil
+}
+
+// GetEPFDetails returns the saved EPF details.
+func (c *ReplayClient) GetEPFDetails(ctx context.Context) (*EPFResponse, error) {
+	var epfResp EPFResponse
+	if err := c.load("epf.json", &epfResp); err != nil {
+		return nil, err
+	}
+	return &epfResp, nil
+}
+
+// GetFundNAVHistory returns the NAVs of the saved history for fundCode that
+// fall between from and to.
+func (c *ReplayClient) GetFundNAVHistory(ctx context.Context, fundCode string, from, to time.Time) (*NAVHistoryResponse, error) {
+	var historyResp NAVHistoryResponse
+	if err := c.load(replayFile("nav_history", fundCode), &historyResp); err != nil {
+		return nil, err
+	}
+
+	first, last := calendarDate(from).Format(dateLayout), calendarDate(to).Format(dateLayout)
+	navs := make([]FundNAV, 0, len(historyResp.Data))
+	for _, nav := range historyResp.Data {
+		if nav.Date >= first && nav.Date <= last {
+			navs = append(navs, nav)
+		}
+	}
+	historyResp.Data = navs
+	return &historyResp, nil
+}
+
+// GetOrderStatus returns the saved status of the order refNo.
+func (c *ReplayClient) GetOrderStatus(ctx context.Context, refNo string) (*OrderStatusResponse, error) {
+	var orderResp OrderStatusResponse
+	if err := c.load(replayFile("order_status", refNo), &orderResp); err != nil {
+		return nil, err
+	}
+	return &orderResp, nil
+}
+
+// Close does nothing, as a ReplayClient holds no resources.
+func (c *ReplayClient) Close() error {
+	return nil
+}
@@ -0,0 +1,141 @@
+package kuvera
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPortfolioFromJSON(t *testing.T) {
+	portfolio, err := PortfolioFromJSON(strings.NewReader(`{"status":"success","data":{"current_value":150000}}`))
+	if err != nil {
+		t.Fatalf("PortfolioFromJSON() error = %v", err)
+	}
+	if portfolio.Data.CurrentValue != 150000 {
+		t.Errorf("CurrentValue = %v, want 150000", portfolio.Data.CurrentValue)
+	}
+
+	if _, err := HoldingsFromJSON(strings.NewReader(`{"AFUND":`)); err == nil {
+		t.Error("HoldingsFromJSON() error = nil, want error for truncated body")
+	}
+}
+
+func TestReplayClient(t *testing.T) {
+	ctx := context.Background()
+	var client KuveraClient = NewReplayClient("testdata/replay")
+
+	login, err := client.Login(ctx, "user@example.com", "secret")
+	if err != nil || login.Token != "jwt-token" {
+		t.Errorf("Login() = %+v, %v", login, err)
+	}
+	if err := client.Ping(ctx); err != nil {
+		t.Errorf("Ping() error = %v", err)
+	}
+	if err := client.Close(); err != nil {
+		t.Errorf("Close() error = %v", err)
+	}
+
+	portfolio, err := client.GetPortfolio(ctx)
+	if err != nil || portfolio.Data.CurrentValue != 150000 {
+		t.Errorf("GetPortfolio() = %+v, %v", portfolio, err)
+	}
+	asOf, err := client.GetPortfolioAsOf(ctx, time.Date(2023, 3, 31, 0, 0, 0, 0, time.UTC))
+	if err != nil || asOf.Data.CurrentValue != 98000 {
+		t.Errorf("GetPortfolioAsOf() = %+v, %v", asOf, err)
+	}
+	account, err := client.GetPortfolioForAccount(ctx, "FAM123")
+	if err != nil || account.Data.CurrentValue != 5000 {
+		t.Errorf("GetPortfolioForAccount() = %+v, %v", account, err)
+	}
+	holdings, err := client.GetHoldings(ctx)
+	if err != nil || len((*holdings)["AFUND"]) != 1 {
+		t.Errorf("GetHoldings() = %+v, %v", holdings, err)
+	}
+	gold, err := client.GetGoldPrice(ctx)
+	if err != nil || gold.CurrentGoldPrice.Buy != 6150.5 {
+		t.Errorf("GetGoldPrice() = %+v, %v", gold, err)
+	}
+	goals, err := client.GetGoals(ctx)
+	if err != nil || len(goals.Data) != 1 || goals.Data[0].Name != "Retirement" {
+		t.Errorf("GetGoals() = %+v, %v", goals, err)
+	}
+	gains, err := client.GetCapitalGains(ctx, "2023-2024")
+	if err != nil || gains.Data.FinancialYear != "2023-2024" {
+		t.Errorf("GetCapitalGains() = %+v, %v", gains, err)
+	}
+	watchlist, err := client.GetWatchlist(ctx)
+	if err != nil || len(watchlist.Data) != 1 {
+		t.Errorf("GetWatchlist() = %+v, %v", watchlist, err)
+	}
+	fund, err := client.GetFundDetails(ctx, "AFUND")
+	if err != nil || fund.Name != "A Fund Direct Growth" {
+		t.Errorf("GetFundDetails() = %+v, %v", fund, err)
+	}
+	dividends, err := client.GetDividends(ctx)
+	if err != nil || len(dividends.Data) != 1 {
+		t.Errorf("GetDividends() = %+v, %v", dividends, err)
+	}
+	equities, err := client.GetEquityHoldings(ctx)
+	if err != nil || equities.Data == nil || len(equities.Data) != 0 {
+		t.Errorf("GetEquityHoldings() = %+v, %v", equities, err)
+	}
+	snapshot, err := client.GetAll(ctx)
+	if err != nil || snapshot.Portfolio.Data.CurrentValue != 150000 || snapshot.Gold.CurrentGoldPrice.Sell != 5990 {
+		t.Errorf("GetAll() = %+v, %v", snapshot, err)
+	}
+	navs, err := client.GetNAVs(ctx, []string{"AFUND", "AFUND"}, time.Date(2024, 3, 28, 0, 0, 0, 0, time.UTC))
+	if err != nil || len(navs) != 1 || navs["AFUND"] != 25.5 {
+		t.Errorf("GetNAVs() = %v, %v", navs, err)
+	}
+	accounts, err := client.GetLinkedAccounts(ctx)
+	if err != nil || len(accounts.Data) != 1 || !accounts.Data[0].Primary {
+		t.Errorf("GetLinkedAccounts() = %+v, %v", accounts, err)
+	}
+	history, err := client.GetPortfolioHistory(ctx, time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 3, 31, 0, 0, 0, 0, time.UTC), GranularityMonthly)
+	if err != nil || len(history.Data) != 2 || history.Data[0].Date != "2023-02-01" {
+		t.Errorf("GetPortfolioHistory() = %+v, %v", history, err)
+	}
+	catalogue, err := client.GetAvailableFunds(ctx, FundFilter{})
+	if err != nil || len(catalogue.Data) != 1 || catalogue.HasNextPage() {
+		t.Errorf("GetAvailableFunds() = %+v, %v", catalogue, err)
+	}
+	epf, err := client.GetEPFDetails(ctx)
+	if err != nil || epf.Data.UAN != "100200300400" || epf.Data.CurrentValue != 450000 {
+		t.Errorf("GetEPFDetails() = %+v, %v", epf, err)
+	}
+	navHistory, err := client.GetFundNAVHistory(ctx, "AFUND", time.Date(2024, 3, 27, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 28, 0, 0, 0, 0, time.UTC))
+	if err != nil || len(navHistory.Data) != 2 || navHistory.Data[1].NAV != 25.5 {
+		t.Errorf("GetFundNAVHistory() = %+v, %v", navHistory, err)
+	}
+	order, err := client.GetOrderStatus(ctx, "TXN123")
+	if err != nil || order.State != OrderStateCompleted || order.UnitsAllotted != 39.216 {
+		t.Errorf("GetOrderStatus() = %+v, %v", order, err)
+	}
+
+	unsupported := map[string]error{
+		"AddToWatchlist":      client.AddToWatchlist(ctx, "AFUND"),
+		"RemoveFromWatchlist": client.RemoveFromWatchlist(ctx, "AFUND"),
+		"CancelSIP":           client.CancelSIP(ctx, 1),
+	}
+	_, unsupported["BuyGold"] = client.BuyGold(ctx, 1000)
+	_, unsupported["SellGold"] = client.SellGold(ctx, 1)
+	_, unsupported["CreateSIP"] = client.CreateSIP(ctx, validSIPRequest())
+	_, unsupported["ImportCAS"] = client.ImportCAS(ctx, []byte("%PDF"), "")
+	for method, err := range unsupported {
+		if !errors.Is(err, ErrReplayUnsupported) {
+			t.Errorf("%s() error = %v, want %v", method, err, ErrReplayUnsupported)
+		}
+	}
+}
+
+func TestReplayClientMissingFile(t *testing.T) {
+	client := NewReplayClient("testdata/replay")
+
+	_, err := client.GetFundDetails(context.Background(), "../holdings")
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("GetFundDetails() error = %v, want %v", err, fs.ErrNotExist)
+	}
+}
@@ -0,0 +1,49 @@
+package kuvera
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"log/slog"
+	"net/http"
+)
+
+// WithRequestIDs tags every API call with a freshly generated UUID, sent in the
+// X-Request-ID header and included in the client's log lines, so that client
+// logs can be tied to specific API calls. Retries of a call reuse its ID.
+//
+// An ID set on the context with WithRequestID takes precedence, and is sent
+// even without this option.
+func WithRequestIDs() ClientOption {
+	return func(c *clientConfig) {
+		c.requestIDs = true
+	}
+}
+
+// newRequestID returns a random (version 4) UUID.
+func newRequestID() string {
+	var b [16]byte
+	rand.Read(b[:])
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// logRequest logs a completed API call at debug level, with its request ID if
+// it has one. The context is passed on so that handlers can extract their own
+// values from it.
+func (c *Client) logRequest(ctx context.Context, method, endpoint string, resp *http.Response, err error) {
+	if c.logger == nil {
+		return
+	}
+
+	attrs := []any{slog.String("method", method), slog.String("endpoint", endpointLabel(endpoint))}
+	if id, ok := requestIDFromContext(ctx); ok {
+		attrs = append(attrs, slog.String("request_id", id))
+	}
+	if err != nil {
+		c.logger.DebugContext(ctx, "kuvera: request failed", append(attrs, slog.Any("error", err))...)
+		return
+	}
+	c.logger.DebugContext(ctx, "kuvera: request completed", append(attrs, slog.Int("status", resp.StatusCode))...)
+}
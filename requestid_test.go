@@ -0,0 +1,74 @@
+package kuvera
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+func TestWithRequestIDs(t *testing.T) {
+	var headers []string
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		headers = append(headers, r.Header.Get("X-Request-ID"))
+		w.Write([]byte(`{"status":"success"}`))
+	}, WithRequestIDs(), WithLogger(logger))
+
+	ctx := context.Background()
+	for range 2 {
+		if err := client.Ping(ctx); err != nil {
+			t.Fatalf("Ping() error = %v", err)
+		}
+	}
+
+	if len(headers) != 2 || headers[0] == headers[1] {
+		t.Fatalf("X-Request-ID headers = %q, want two distinct IDs", headers)
+	}
+	lines := strings.Split(strings.TrimSpace(logs.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d log lines, want 2:\n%s", len(lines), logs.String())
+	}
+	for i, id := range headers {
+		if !uuidPattern.MatchString(id) {
+			t.Errorf("X-Request-ID = %q, want a UUID", id)
+		}
+		if !strings.Contains(lines[i], "request_id="+id) {
+			t.Errorf("log line %q does not contain request ID %s", lines[i], id)
+		}
+	}
+}
+
+func TestWithRequestIDOverride(t *testing.T) {
+	var got string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("X-Request-ID")
+		w.Write([]byte(`{"status":"success"}`))
+	}, WithRequestIDs())
+
+	if err := client.Ping(WithRequestID(context.Background(), "trace-42")); err != nil {
+		t.Fatalf("Ping() error = %v", err)
+	}
+	if got != "trace-42" {
+		t.Errorf("X-Request-ID = %q, want trace-42", got)
+	}
+}
+
+func TestRequestIDsDisabledByDefault(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := r.Header["X-Request-Id"]; ok {
+			t.Errorf("X-Request-ID = %q, want no header", r.Header.Get("X-Request-ID"))
+		}
+		w.Write([]byte(`{"status":"success"}`))
+	})
+
+	if err := client.Ping(context.Background()); err != nil {
+		t.Fatalf("Ping() error = %v", err)
+	}
+}
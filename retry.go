@@ -0,0 +1,119 @@
+package kuvera
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// defaultRetryableStatusCodes are the statuses retried unless overridden with
+// WithRetryableStatusCodes.
+var defaultRetryableStatusCodes = []int{
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// WithRetry retries failed requests up to maxRetries times, waiting backoff
+// before the first retry and doubling the wait after each attempt.
+//
+// Requests are retried on network errors and on the statuses configured with
+// WithRetryableStatusCodes (502, 503, and 504 by default). Only requests that
+// are safe to repeat are retried: GET, HEAD, and DELETE requests, and requests
+// whose context carries an idempotency key (see WithIdempotencyKey).
+func WithRetry(maxRetries int, backoff time.Duration) ClientOption {
+	return func(c *clientConfig) {
+		c.maxRetries = maxRetries
+		c.retryBackoff = backoff
+	}
+}
+
+// WithRetryableStatusCodes replaces the set of HTTP statuses that are retried
+// when retries are enabled with WithRetry. Codes outside the 4xx and 5xx
+// ranges are ignored.
+//
+// Example:
+//
+//	client := kuvera.NewClient(
+//		kuvera.WithRetry(3, time.Second),
+//		kuvera.WithRetryableStatusCodes(429, 502, 503, 504),
+//	)
+func WithRetryableStatusCodes(codes ...int) ClientOption {
+	return func(c *clientConfig) {
+		c.retryableStatus = make(map[int]bool, len(codes))
+		for _, code := range codes {
+			if code >= 400 && code <= 599 {
+				c.retryableStatus[code] = true
+			}
+		}
+	}
+}
+
+// retrySafe reports whether a request may be sent more than once.
+func retrySafe(ctx context.Context, method string) bool {
+	switch method {
+	case "GET", "HEAD", "DELETE":
+		return true
+	}
+	_, ok := idempotencyKeyFromContext(ctx)
+	return ok
+}
+
+// doRequestWithRetry executes a request, retrying it as configured by WithRetry.
+func (c *Client) doRequestWithRetry(ctx context.Context, method, endpoint string, payload interface{}) (*http.Response, error) {
+	attempts := 1
+	if c.maxRetries > 0 && retrySafe(ctx, method) {
+		attempts += c.maxRetries
+	}
+
+	backoff := c.retryBackoff
+	for attempt := 1; ; attempt++ {
+		resp, err := c.doRequest(ctx, method, endpoint, payload)
+		if attempt == attempts || !c.shouldRetry(ctx, resp, err) {
+			return resp, err
+		}
+		if resp != nil {
+			// Drain so the connection can be reused by the next attempt
+			io.Copy(io.Discard, io.LimitReader(resp.Body, errorPrefixBytes))
+			resp.Body.Close()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("retry wait failed: %w", ctx.Err())
+		case <-c.clock.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// shouldRetry reports whether a request that produced resp and err is worth
+// retrying: only transport failures and the configured statuses are. Errors
+// raised by the client itself, such as ErrResponseTooLarge or
+// ErrTooManyRedirects, would fail the same way again.
+func (c *Client) shouldRetry(ctx context.Context, resp *http.Response, err error) bool {
+	if err != nil {
+		return ctx.Err() == nil && isTransportError(err)
+	}
+	return c.retryableStatus[resp.StatusCode]
+}
+
+// isTransportError reports whether err is a network failure of the HTTP
+// transport, such as a refused or reset connection or a timeout, as opposed
+// to a cancelled context or an error returned by a hook or redirect policy.
+func isTransportError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		return false
+	}
+	var netErr net.Error
+	return errors.As(urlErr.Err, &netErr) || errors.Is(urlErr.Err, io.EOF) || errors.Is(urlErr.Err, io.ErrUnexpectedEOF)
+}
@@ -0,0 +1,141 @@
+package kuvera
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// flakyHandler fails the first failures requests with status, then succeeds.
+func flakyHandler(hits *atomic.Int32, failures int32, status int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if hits.Add(1) <= failures {
+			w.WriteHeader(status)
+			w.Write([]byte(`{"code":0,"message":"try again"}`))
+			return
+		}
+		w.Write([]byte(`{"status":"success"}`))
+	}
+}
+
+func TestWithRetryableStatusCodes(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  []ClientOption
+		status   int
+		wantErr  bool
+		wantHits int32
+	}{
+		{"default retries 503", nil, http.StatusServiceUnavailable, false, 2},
+		{"default does not retry 500", nil, http.StatusInternalServerError, true, 1},
+		{"custom set retries 500", []ClientOption{WithRetryableStatusCodes(500)}, http.StatusInternalServerError, false, 2},
+		{"custom set excludes 503", []ClientOption{WithRetryableStatusCodes(429)}, http.StatusServiceUnavailable, true, 1},
+		{"out of range codes ignored", []ClientOption{WithRetryableStatusCodes(200, 302, 700)}, http.StatusServiceUnavailable, true, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var hits atomic.Int32
+			options := append([]ClientOption{WithRetry(3, time.Second), withClock(newFakeClock())}, tt.options...)
+			client := newTestClient(t, flakyHandler(&hits, 1, tt.status), options...)
+
+			_, err := client.GetPortfolio(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetPortfolio() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if got := hits.Load(); got != tt.wantHits {
+				t.Errorf("server hits = %d, want %d", got, tt.wantHits)
+			}
+		})
+	}
+}
+
+func TestWithRetryBackoff(t *testing.T) {
+	var hits atomic.Int32
+	clk := newFakeClock()
+	start := clk.Now()
+	client := newTestClient(t, flakyHandler(&hits, 3, http.StatusBadGateway), WithRetry(3, time.Second), withClock(clk))
+
+	if _, err := client.GetPortfolio(context.Background()); err != nil {
+		t.Fatalf("GetPortfolio() error = %v", err)
+	}
+	// Backoff doubles: 1s + 2s + 4s
+	if waited := clk.Now().Sub(start); waited != 7*time.Second {
+		t.Errorf("total backoff = %v, want 7s", waited)
+	}
+}
+
+func TestWithRetrySkipsUnsafeRequests(t *testing.T) {
+	var hits atomic.Int32
+	client := newTestClient(t, flakyHandler(&hits, 1, http.StatusServiceUnavailable),
+		WithRetry(3, time.Second), withClock(newFakeClock()), WithTransactionsEnabled())
+
+	if _, err := client.BuyGold(context.Background(), 1000); err == nil {
+		t.Error("BuyGold() error = nil, want error from the unretried failure")
+	}
+	if got := hits.Load(); got != 1 {
+		t.Errorf("server hits = %d, want 1", got)
+	}
+
+	hits.Store(0)
+	ctx := WithIdempotencyKey(context.Background(), "order-1")
+	if _, err := client.BuyGold(ctx, 1000); err != nil {
+		t.Errorf("BuyGold() with idempotency key error = %v", err)
+	}
+	if got := hits.Load(); got != 2 {
+		t.Errorf("server hits with idempotency key = %d, want 2", got)
+	}
+}
+
+func TestWithRetryRetriesDroppedConnections(t *testing.T) {
+	var hits atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if hits.Add(1) == 1 {
+			// Drop the connection without a response
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err == nil {
+				conn.Close()
+			}
+			return
+		}
+		w.Write([]byte(`{"status":"success"}`))
+	}, WithRetry(3, time.Second), withClock(newFakeClock()))
+
+	if _, err := client.GetPortfolio(context.Background()); err != nil {
+		t.Fatalf("GetPortfolio() error = %v", err)
+	}
+	if got := hits.Load(); got != 2 {
+		t.Errorf("server hits = %d, want 2", got)
+	}
+}
+
+func TestShouldRetryOnlyTransportErrors(t *testing.T) {
+	client := NewClient(WithRetry(3, time.Second)).(*Client)
+	transportErr := &url.Error{Op: "Get", URL: "https://api.kuvera.in", Err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}}
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"dial error", fmt.Errorf("failed to execute request: %w", transportErr), true},
+		{"connection closed", &url.Error{Op: "Get", URL: "https://api.kuvera.in", Err: io.EOF}, true},
+		{"too many redirects", &url.Error{Op: "Get", URL: "https://api.kuvera.in", Err: fmt.Errorf("%w: stopped after 3", ErrTooManyRedirects)}, false},
+		{"context canceled", &url.Error{Op: "Get", URL: "https://api.kuvera.in", Err: context.Canceled}, false},
+		{"aborted by hook", fmt.Errorf("%w: no", ErrRequestAborted), false},
+		{"response too large", ErrResponseTooLarge, false},
+		{"unknown client error", errors.New("something new"), false},
+	}
+	for _, tt := range tests {
+		if got := client.shouldRetry(context.Background(), nil, tt.err); got != tt.want {
+			t.Errorf("shouldRetry(%s) = %t, want %t", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package kuvera
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// LoginWithSessionToken authenticates with the token of an existing Kuvera web
+// session, such as one copied from a logged-in browser, instead of a password.
+// sessionID is the session's X-Session-ID, if any; an empty sessionID keeps
+// the client's current one.
+//
+// The token is validated straight away with a lightweight profile request. On
+// success the token and session are kept for subsequent requests, and the
+// profile is returned as a LoginResponse with Token and SessionID filled in.
+// If Kuvera rejects the token, the error is ErrTokenExpired for an expired
+// token and ErrNotAuthenticated otherwise, and the client's previous token and
+// session are restored. An empty token fails with ErrNotAuthenticated without
+// a request.
+//
+// Example:
+//
+//	client := kuvera.NewClient().(*kuvera.Client)
+//	resp, err := client.LoginWithSessionToken(ctx, os.Getenv("KUVERA_TOKEN"), "")
+//	if errors.Is(err, kuvera.ErrTokenExpired) {
+//		log.Fatal("the browser session has expired, log in again")
+//	}
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Welcome %s\n", resp.Name)
+func (c *Client) LoginWithSessionToken(ctx context.Context, token, sessionID string) (*LoginResponse, error) {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return nil, ErrNotAuthenticated
+	}
+	sessionID = strings.TrimSpace(sessionID)
+
+	previousToken, previousSessionID := c.token(), c.session()
+	c.setToken(token)
+	if sessionID != "" {
+		c.setSession(sessionID)
+	}
+	if c.cache != nil {
+		c.cache.clear()
+	}
+
+	loginResp, err := c.validateSession(ctx)
+	if err != nil {
+		c.setToken(previousToken)
+		c.setSession(previousSessionID)
+		return nil, err
+	}
+
+	loginResp.Token = token
+	loginResp.SessionID = c.session()
+	return loginResp, nil
+}
+
+// validateSession fetches the profile with the current token, mapping a
+// rejected token to ErrTokenExpired or ErrNotAuthenticated.
+func (c *Client) validateSession(ctx context.Context) (*LoginResponse, error) {
+	resp, err := c.makeRequest(ctx, "GET", "/api/v5/users/profile.json", nil)
+	if err != nil {
+		return nil, fmt.Errorf("session validation request failed: %w", err)
+	}
+
+	var loginResp LoginResponse
+	if err := c.handleResponse(resp, &loginResp, "session validation"); err != nil {
+		if errors.Is(err, ErrTokenExpired) {
+			return nil, err
+		}
+		if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+			return nil, fmt.Errorf("%w: session token rejected: %v", ErrNotAuthenticated, err)
+		}
+		return nil, err
+	}
+	return &loginResp, nil
+}
@@ -0,0 +1,101 @@
+package kuvera
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+)
+
+func TestLoginWithSessionToken(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v5/users/profile.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer browser-token" {
+			t.Errorf("Authorization = %q, want the injected token", got)
+		}
+		if got := r.Header.Get("X-Session-ID"); got != "browser-session" {
+			t.Errorf("X-Session-ID = %q, want the injected session", got)
+		}
+		w.Write([]byte(`{"status":"success","name":"Test User","email":"user@example.com"}`))
+	})
+
+	resp, err := client.LoginWithSessionToken(context.Background(), " browser-token ", "browser-session")
+	if err != nil {
+		t.Fatalf("LoginWithSessionToken() error = %v", err)
+	}
+	if resp.Name != "Test User" || resp.Token != "browser-token" || resp.SessionID != "browser-session" {
+		t.Errorf("LoginWithSessionToken() = %+v", resp)
+	}
+	if client.token() != "browser-token" || client.SessionID() != "browser-session" {
+		t.Errorf("token, session = %q, %q, want the injected ones", client.token(), client.SessionID())
+	}
+}
+
+func TestLoginWithSessionTokenRejected(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		wantErr error
+	}{
+		{"expired", `{"code":401,"message":"Unauthorized","error":"Signature has expired"}`, ErrTokenExpired},
+		{"invalid", `{"code":401,"message":"Unauthorized","error":"Invalid token"}`, ErrNotAuthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusUnauthorized)
+				w.Write([]byte(tt.body))
+			}, WithSessionID("original-session"))
+
+			resp, err := client.LoginWithSessionToken(context.Background(), "bad-token", "bad-session")
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("LoginWithSessionToken() error = %v, want %v", err, tt.wantErr)
+			}
+			if resp != nil {
+				t.Errorf("LoginWithSessionToken() = %+v, want nil", resp)
+			}
+			if client.token() != "test-token" || client.SessionID() != "original-session" {
+				t.Errorf("token, session = %q, %q, want the previous ones restored", client.token(), client.SessionID())
+			}
+		})
+	}
+}
+
+func TestLoginWithSessionTokenEmpty(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request expected for an empty token")
+	})
+
+	if _, err := client.LoginWithSessionToken(context.Background(), " ", "session"); !errors.Is(err, ErrNotAuthenticated) {
+		t.Errorf("LoginWithSessionToken() error = %v, want %v", err, ErrNotAuthenticated)
+	}
+}
+
+// TestLoginWithSessionTokenConcurrent is meant for the race detector.
+func TestLoginWithSessionTokenConcurrent(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":"success","name":"Test User"}`))
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if _, err := client.LoginWithSessionToken(context.Background(), "browser-token", "browser-session"); err != nil {
+				t.Errorf("LoginWithSessionToken() error = %v", err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if err := client.Ping(context.Background()); err != nil {
+				t.Errorf("Ping() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,163 @@
+package kuvera
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SIP errors
+var (
+	ErrInvalidFrequency = errors.New("invalid SIP frequency")
+	ErrInvalidSIPID     = errors.New("SIP ID must be positive")
+	ErrEmptyStartDate   = errors.New("SIP start date cannot be empty")
+	ErrEmptyMandateID   = errors.New("mandate ID cannot be empty")
+)
+
+// SIPFrequency is how often a SIP installment is debited.
+type SIPFrequency string
+
+// Supported SIP frequencies.
+const (
+	SIPFrequencyWeekly    SIPFrequency = "Weekly"
+	SIPFrequencyMonthly   SIPFrequency = "Monthly"
+	SIPFrequencyQuarterly SIPFrequency = "Quarterly"
+)
+
+// valid reports whether f is a supported frequency.
+func (f SIPFrequency) valid() bool {
+	switch f {
+	case SIPFrequencyWeekly, SIPFrequencyMonthly, SIPFrequencyQuarterly:
+		return true
+	}
+	return false
+}
+
+// SIPCreateRequest describes a SIP to register with CreateSIP.
+type SIPCreateRequest struct {
+	// FundCode is the scheme code of the fund to invest in
+	FundCode string
+	// Amount is the installment amount in INR
+	Amount float64
+	// Frequency is how often the installment is debited
+	Frequency SIPFrequency
+	// StartDate is the date of the first installment
+	StartDate time.Time
+	// MandateID is the bank mandate used to debit the installments
+	MandateID string
+}
+
+// Validate checks the request before it is sent: the fund code must be valid
+// (see ValidFundCode), the amount positive, the frequency one of the
+// SIPFrequency constants, and the start date and mandate ID set.
+//
+// Every violation is reported: the returned error joins one error per problem,
+// each matching its sentinel (ErrInvalidAmount, ErrInvalidFrequency, ...) with
+// errors.Is. It returns nil for a valid request.
+func (r SIPCreateRequest) Validate() error {
+	var errs []error
+	if err := validateFundCode(r.FundCode); err != nil {
+		errs = append(errs, err)
+	}
+	if r.Amount <= 0 {
+		errs = append(errs, fmt.Errorf("%w: %v", ErrInvalidAmount, r.Amount))
+	}
+	if !r.Frequency.valid() {
+		errs = append(errs, fmt.Errorf("%w: %q", ErrInvalidFrequency, r.Frequency))
+	}
+	if r.StartDate.IsZero() {
+		errs = append(errs, ErrEmptyStartDate)
+	}
+	if strings.TrimSpace(r.MandateID) == "" {
+		errs = append(errs, ErrEmptyMandateID)
+	}
+	return errors.Join(errs...)
+}
+
+// sipCreatePayload is the request payload for SIP registration.
+type sipCreatePayload struct {
+	AMCAmfiCodeTo string  `json:"amc_amfi_code_to"`
+	Amount        float64 `json:"amount"`
+	Frequency     string  `json:"frequency"`
+	StartDate     string  `json:"start_date"`
+	MandateID     string  `json:"mandate_id"`
+}
+
+// CreateSIP registers a new SIP.
+//
+// This moves money: the client must be constructed with WithTransactionsEnabled,
+// otherwise ErrTransactionsDisabled is returned. The request must pass Validate,
+// which reports every problem with it at once. The user must be authenticated
+// (logged in) before calling this method.
+//
+// Example:
+//
+//	sip, err := client.CreateSIP(ctx, kuvera.SIPCreateRequest{
+//		FundCode:  "INF179K01BE2",
+//		Amount:    5000,
+//		Frequency: kuvera.SIPFrequencyMonthly,
+//		StartDate: time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC),
+//		MandateID: "MNDT123",
+//	})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Registered SIP %d (%s)\n", sip.ID, sip.State)
+func (c *Client) CreateSIP(ctx context.Context, req SIPCreateRequest) (*SIPDetail, error) {
+	if !c.transactionsEnabled {
+		return nil, ErrTransactionsDisabled
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if c.token() == "" {
+		return nil, ErrNotAuthenticated
+	}
+
+	payload := sipCreatePayload{
+		AMCAmfiCodeTo: req.FundCode,
+		Amount:        req.Amount,
+		Frequency:     string(req.Frequency),
+		StartDate:     req.StartDate.Format(dateLayout),
+		MandateID:     req.MandateID,
+	}
+	resp, err := c.makeRequest(ctx, "POST", "/api/v3/sips.json", payload)
+	if err != nil {
+		return nil, fmt.Errorf("create SIP request failed: %w", err)
+	}
+
+	var sip SIPDetail
+	if err := c.handleResponse(resp, &sip, "create SIP"); err != nil {
+		return &sip, err
+	}
+
+	return &sip, nil
+}
+
+// CancelSIP cancels the SIP with the given ID.
+//
+// This moves money: the client must be constructed with WithTransactionsEnabled,
+// otherwise ErrTransactionsDisabled is returned. The user must be authenticated
+// (logged in) before calling this method.
+func (c *Client) CancelSIP(ctx context.Context, sipID int) error {
+	if !c.transactionsEnabled {
+		return ErrTransactionsDisabled
+	}
+	if sipID <= 0 {
+		return ErrInvalidSIPID
+	}
+	if c.token() == "" {
+		return ErrNotAuthenticated
+	}
+
+	endpoint := "/api/v3/sips/" + strconv.Itoa(sipID) + ".json"
+	resp, err := c.makeRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("cancel SIP request failed: %w", err)
+	}
+
+	return c.handleResponse(resp, nil, "cancel SIP")
+}
@@ -0,0 +1,74 @@
+package kuvera
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Words that mark the payment status or BSE message of a SIP's last order as
+// failed or successful. They are matched case-insensitively as substrings.
+var (
+	sipFailureWords = []string{"fail", "reject", "bounce", "declin", "insufficient"}
+	sipSuccessWords = []string{"success", "paid", "complete", "confirm", "approved"}
+)
+
+// FailedSIPs returns the active and paused SIPs whose last installment failed,
+// for example because of an insufficient balance or a rejected mandate, so that
+// the user can fix the mandate or top up the account before the next one.
+// The SIPs are ordered by fund code and then SIP ID.
+//
+// Kuvera reports the outcome of the last order inconsistently, so the result
+// is a heuristic:
+//
+//   - OrderPaymentStatus decides when it is a string mentioning failure
+//     ("failed", "rejected", "bounced", "declined", "insufficient funds") or
+//     success ("success", "paid", "completed", "confirmed",
+//     "approved"). Failure wins if both appear.
+//   - Otherwise, BSEMessage is checked for the same failure words.
+//   - Without either signal the SIP is assumed healthy.
+//
+// Cancelled and completed SIPs are skipped, since they will not be debited
+// again.
+func (h HoldingsResponse) FailedSIPs() []SIPDetail {
+	failed := make([]SIPDetail, 0)
+	for _, code := range h.FundCodes() {
+		var fundFailed []SIPDetail
+		for _, holding := range h[code] {
+			for _, sip := range holding.SIPs {
+				if sip.State != SIPStateCancelled && sip.State != SIPStateCompleted && sip.lastOrderFailed() {
+					fundFailed = append(fundFailed, sip)
+				}
+			}
+		}
+		sort.Slice(fundFailed, func(i, j int) bool {
+			return fundFailed[i].ID < fundFailed[j].ID
+		})
+		failed = append(failed, fundFailed...)
+	}
+	return failed
+}
+
+// lastOrderFailed applies the FailedSIPs heuristic to the SIP's last order.
+func (s SIPDetail) lastOrderFailed() bool {
+	if s.OrderPaymentStatus != nil {
+		status := strings.ToLower(fmt.Sprint(s.OrderPaymentStatus))
+		if containsAny(status, sipFailureWords) {
+			return true
+		}
+		if containsAny(status, sipSuccessWords) {
+			return false
+		}
+	}
+	return containsAny(strings.ToLower(s.BSEMessage), sipFailureWords)
+}
+
+// containsAny reports whether s contains any of words.
+func containsAny(s string, words []string) bool {
+	for _, word := range words {
+		if strings.Contains(s, word) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,39 @@
+package kuvera
+
+import "testing"
+
+func TestFailedSIPs(t *testing.T) {
+	holdings := HoldingsResponse{
+		"BFUND": {{SIPs: []SIPDetail{
+			{ID: 9, OrderPaymentStatus: "FAILED", State: SIPStateActive},
+			{ID: 3, BSEMessage: "REJECTED: insufficient balance", State: SIPStatePaused},
+			{ID: 4, OrderPaymentStatus: "SUCCESS", BSEMessage: "Mandate rejected earlier", State: SIPStateActive},
+		}}},
+		"AFUND": {
+			{SIPs: []SIPDetail{{ID: 5, OrderPaymentStatus: "payment_failed", State: SIPStateActive}}},
+			{SIPs: []SIPDetail{
+				{ID: 6, BSEMessage: "Order confirmed", State: SIPStateActive},
+				{ID: 7, State: SIPStateActive},
+			}},
+		},
+		"CFUND": {{SIPs: []SIPDetail{
+			{ID: 1, OrderPaymentStatus: "FAILED", State: SIPStateCancelled},
+			{ID: 2, BSEMessage: "Bounced", State: SIPStateCompleted},
+		}}},
+	}
+
+	failed := holdings.FailedSIPs()
+	want := []int{5, 3, 9}
+	if len(failed) != len(want) {
+		t.Fatalf("FailedSIPs() = %+v, want IDs %v", failed, want)
+	}
+	for i, id := range want {
+		if failed[i].ID != id {
+			t.Errorf("FailedSIPs()[%d].ID = %d, want %d", i, failed[i].ID, id)
+		}
+	}
+
+	if failed := (HoldingsResponse{}).FailedSIPs(); failed == nil || len(failed) != 0 {
+		t.Errorf("FailedSIPs() on empty holdings = %#v, want empty slice", failed)
+	}
+}
@@ -0,0 +1,171 @@
+package kuvera
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// UpcomingSIP is a SIP installment projected to be debited soon.
+type UpcomingSIP struct {
+	// FundCode is the code of the fund the SIP invests in
+	FundCode string
+	// SIP is the SIP the installment belongs to
+	SIP SIPDetail
+	// Date is the projected debit date
+	Date time.Time
+	// Amount is the installment amount
+	Amount float64
+}
+
+// UpcomingSIPs projects the next debit date of every active SIP and returns
+// those falling between from and from+within, sorted by date. Only the next
+// installment of each SIP is reported, even if the window spans several.
+//
+// Each SIP's schedule is anchored on its start date, stepping by its weekly,
+// monthly or quarterly frequency; installments on or before the SIP's last
+// order trigger date are treated as already debited. Monthly and quarterly SIPs
+// keep their day of month, falling back to the last day of shorter months (a
+// SIP started on the 31st is debited on 28 or 29 February). Both ends of the
+// window are compared by calendar date, so installments due on from's date are
+// included.
+//
+// SIPs that are not active, have an unrecognised frequency or no parseable
+// start or trigger date, or that end before their next installment are
+// skipped. An empty, non-nil slice is returned when nothing is due.
+func (h HoldingsResponse) UpcomingSIPs(within time.Duration, from time.Time) []UpcomingSIP {
+	first, last := calendarDate(from), calendarDate(from.Add(within))
+
+	upcoming := make([]UpcomingSIP, 0)
+	for fundCode, holdings := range h {
+		for _, holding := range holdings {
+			for _, sip := range holding.SIPs {
+				next, ok := sip.nextDebit(first)
+				if ok && !next.After(last) {
+					upcoming = append(upcoming, UpcomingSIP{FundCode: fundCode, SIP: sip, Date: next, Amount: sip.Amount})
+				}
+			}
+		}
+	}
+
+	sort.Slice(upcoming, func(i, j int) bool {
+		a, b := upcoming[i], upcoming[j]
+		if !a.Date.Equal(b.Date) {
+			return a.Date.Before(b.Date)
+		}
+		if a.FundCode != b.FundCode {
+			return a.FundCode < b.FundCode
+		}
+		return a.SIP.ID < b.SIP.ID
+	})
+	return upcoming
+}
+
+// nextDebit returns the SIP's first installment on or after the given date.
+// It reports false if the SIP will not be debited again or its schedule
+// cannot be determined.
+func (s SIPDetail) nextDebit(notBefore time.Time) (time.Time, bool) {
+	if s.State != SIPStateActive {
+		return time.Time{}, false
+	}
+	frequency, ok := parseSIPFrequency(s.Frequency)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	var lastDebit time.Time
+	if s.OrderTriggerDate != "" {
+		if t, err := parseKuveraDate(s.OrderTriggerDate); err == nil {
+			lastDebit = calendarDate(t)
+		}
+	}
+	anchor := lastDebit
+	if start, err := s.Start(); err == nil {
+		anchor = calendarDate(start)
+	}
+	if anchor.IsZero() {
+		return time.Time{}, false
+	}
+	end, err := s.End()
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	// Skip straight to the installments near the window, so that implausible
+	// dates spanning centuries do not cost an installment per iteration
+	target := notBefore
+	if lastDebit.After(target) {
+		target = lastDebit
+	}
+	for n := max(frequency.installmentsBetween(anchor, target)-1, 0); ; n++ {
+		next := frequency.installment(anchor, n)
+		if next.Before(notBefore) || !next.After(lastDebit) {
+			continue
+		}
+		if !end.IsZero() && next.After(calendarDate(end)) {
+			return time.Time{}, false
+		}
+		return next, true
+	}
+}
+
+// parseSIPFrequency maps a frequency as sent by Kuvera to a SIPFrequency,
+// ignoring case.
+func parseSIPFrequency(s string) (SIPFrequency, bool) {
+	for _, f := range []SIPFrequency{SIPFrequencyWeekly, SIPFrequencyMonthly, SIPFrequencyQuarterly} {
+		if strings.EqualFold(strings.TrimSpace(s), string(f)) {
+			return f, true
+		}
+	}
+	return "", false
+}
+
+// installment returns the date of the nth installment of a SIP starting on
+// start. Installments are computed from the start date rather than from each
+// other, so a month-end clamp does not carry over into later months.
+func (f SIPFrequency) installment(start time.Time, n int) time.Time {
+	switch f {
+	case SIPFrequencyWeekly:
+		return start.AddDate(0, 0, 7*n)
+	case SIPFrequencyQuarterly:
+		return addMonthsClamped(start, 3*n)
+	default:
+		return addMonthsClamped(start, n)
+	}
+}
+
+// installmentsBetween returns a lower bound on the number of installments of a
+// SIP starting on start that fall before t.
+func (f SIPFrequency) installmentsBetween(start, t time.Time) int {
+	if !t.After(start) {
+		return 0
+	}
+	switch f {
+	case SIPFrequencyWeekly:
+		return int((t.Unix() - start.Unix()) / (7 * 24 * 60 * 60))
+	case SIPFrequencyQuarterly:
+		return monthsBetween(start, t) / 3
+	default:
+		return monthsBetween(start, t)
+	}
+}
+
+// monthsBetween returns the number of month boundaries from a to b.
+func monthsBetween(a, b time.Time) int {
+	return (b.Year()-a.Year())*12 + int(b.Month()) - int(a.Month())
+}
+
+// addMonthsClamped adds months to t, clamping the day to the end of the
+// resulting month instead of overflowing into the next one as time.AddDate does.
+func addMonthsClamped(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+	firstOfMonth := time.Date(year, month+time.Month(months), 1, 0, 0, 0, 0, t.Location())
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+	return firstOfMonth.AddDate(0, 0, min(day, lastDay)-1)
+}
+
+// calendarDate returns midnight UTC of t's date in its own location.
+func calendarDate(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
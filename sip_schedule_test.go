@@ -0,0 +1,92 @@
+package kuvera
+
+import (
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestUpcomingSIPs(t *testing.T) {
+	holdings := HoldingsResponse{
+		"MONTHLY": {{SIPs: []SIPDetail{
+			{ID: 1, Amount: 5000, Frequency: "Monthly", StartDate: "2023-01-31", OrderTriggerDate: "2024-01-31", State: SIPStateActive},
+		}}},
+		"WEEKLY": {{SIPs: []SIPDetail{
+			{ID: 2, Amount: 500, Frequency: "weekly", StartDate: "2024-01-03", State: SIPStateActive},
+		}}},
+		"QUARTERLY": {{SIPs: []SIPDetail{
+			{ID: 3, Amount: 15000, Frequency: "Quarterly", StartDate: "2023-11-30", State: SIPStateActive},
+			{ID: 4, Amount: 15000, Frequency: "Quarterly", StartDate: "2023-12-10", State: SIPStateActive},
+		}}},
+		"INACTIVE": {{SIPs: []SIPDetail{
+			{ID: 5, Amount: 1000, Frequency: "Monthly", StartDate: "2023-01-15", State: SIPStateCancelled},
+			{ID: 6, Amount: 1000, Frequency: "Monthly", StartDate: "2023-01-15", EndDate: "2024-02-10", State: SIPStateActive},
+			{ID: 7, Amount: 1000, Frequency: "Fortnightly", StartDate: "2023-01-15", State: SIPStateActive},
+		}}},
+	}
+
+	from := time.Date(2024, 2, 14, 9, 30, 0, 0, time.UTC)
+	upcoming := holdings.UpcomingSIPs(15*24*time.Hour, from)
+
+	// Only the next installment of each SIP is reported
+	want := []struct {
+		id   int
+		date time.Time
+	}{
+		{2, date(2024, 2, 14)}, // due today
+		{1, date(2024, 2, 29)}, // 31st clamped to the end of February
+		{3, date(2024, 2, 29)}, // 30th clamped to the end of February
+	}
+	if len(upcoming) != len(want) {
+		t.Fatalf("UpcomingSIPs() returned %d installments, want %d: %+v", len(upcoming), len(want), upcoming)
+	}
+	for i, w := range want {
+		if upcoming[i].SIP.ID != w.id || !upcoming[i].Date.Equal(w.date) {
+			t.Errorf("upcoming[%d] = SIP %d on %s, want SIP %d on %s",
+				i, upcoming[i].SIP.ID, upcoming[i].Date.Format(dateLayout), w.id, w.date.Format(dateLayout))
+		}
+	}
+	if upcoming[1].Amount != 5000 || upcoming[1].FundCode != "MONTHLY" {
+		t.Errorf("upcoming[1] = %+v, want 5000 for MONTHLY", upcoming[1])
+	}
+}
+
+func TestUpcomingSIPsMonthEndRollover(t *testing.T) {
+	sip := SIPDetail{Frequency: "Monthly", StartDate: "2024-01-31", State: SIPStateActive}
+
+	tests := []struct {
+		from time.Time
+		want time.Time
+	}{
+		{date(2024, 2, 1), date(2024, 2, 29)},
+		{date(2024, 3, 1), date(2024, 3, 31)},
+		{date(2024, 4, 1), date(2024, 4, 30)},
+		{date(2024, 12, 31), date(2024, 12, 31)},
+		{date(2025, 1, 1), date(2025, 1, 31)},
+		{date(2025, 2, 1), date(2025, 2, 28)},
+	}
+	for _, tt := range tests {
+		next, ok := sip.nextDebit(tt.from)
+		if !ok || !next.Equal(tt.want) {
+			t.Errorf("nextDebit(%s) = %s, %t, want %s",
+				tt.from.Format(dateLayout), next.Format(dateLayout), ok, tt.want.Format(dateLayout))
+		}
+	}
+}
+
+func TestUpcomingSIPsSkipsDebitedInstallment(t *testing.T) {
+	holdings := HoldingsResponse{"FUND": {{SIPs: []SIPDetail{
+		{Frequency: "Monthly", StartDate: "2023-06-05", OrderTriggerDate: "2024-03-05T10:00:00Z", State: SIPStateActive},
+	}}}}
+
+	upcoming := holdings.UpcomingSIPs(7*24*time.Hour, date(2024, 3, 5))
+	if len(upcoming) != 0 {
+		t.Errorf("UpcomingSIPs() = %+v, want none after the installment was triggered", upcoming)
+	}
+	if upcoming == nil {
+		t.Error("UpcomingSIPs() = nil, want an empty slice")
+	}
+}
@@ -0,0 +1,149 @@
+package kuvera
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func validSIPRequest() SIPCreateRequest {
+	return SIPCreateRequest{
+		FundCode:  "INF179K01BE2",
+		Amount:    5000,
+		Frequency: SIPFrequencyMonthly,
+		StartDate: time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC),
+		MandateID: "MNDT123",
+	}
+}
+
+func TestSIPDisabledByDefault(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request expected while transactions are disabled")
+	})
+
+	if _, err := client.CreateSIP(context.Background(), validSIPRequest()); !errors.Is(err, ErrTransactionsDisabled) {
+		t.Errorf("CreateSIP() error = %v, want %v", err, ErrTransactionsDisabled)
+	}
+	if err := client.CancelSIP(context.Background(), 42); !errors.Is(err, ErrTransactionsDisabled) {
+		t.Errorf("CancelSIP() error = %v, want %v", err, ErrTransactionsDisabled)
+	}
+}
+
+func TestCreateSIPValidation(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request expected for an invalid SIP")
+	}, WithTransactionsEnabled())
+
+	tests := []struct {
+		name    string
+		modify  func(*SIPCreateRequest)
+		wantErr error
+	}{
+		{"empty fund code", func(r *SIPCreateRequest) { r.FundCode = " " }, ErrEmptyFundCode},
+		{"zero amount", func(r *SIPCreateRequest) { r.Amount = 0 }, ErrInvalidAmount},
+		{"negative amount", func(r *SIPCreateRequest) { r.Amount = -500 }, ErrInvalidAmount},
+		{"missing frequency", func(r *SIPCreateRequest) { r.Frequency = "" }, ErrInvalidFrequency},
+		{"unknown frequency", func(r *SIPCreateRequest) { r.Frequency = "Hourly" }, ErrInvalidFrequency},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validSIPRequest()
+			tt.modify(&req)
+			if _, err := client.CreateSIP(context.Background(), req); !errors.Is(err, tt.wantErr) {
+				t.Errorf("CreateSIP() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if err := client.CancelSIP(context.Background(), 0); !errors.Is(err, ErrInvalidSIPID) {
+		t.Errorf("CancelSIP() error = %v, want %v", err, ErrInvalidSIPID)
+	}
+}
+
+func TestSIPCreateRequestValidate(t *testing.T) {
+	if err := validSIPRequest().Validate(); err != nil {
+		t.Errorf("Validate() of a valid request error = %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*SIPCreateRequest)
+		want   []error
+	}{
+		{"invalid fund code", func(r *SIPCreateRequest) { r.FundCode = "bad code" }, []error{ErrInvalidFundCode}},
+		{"zero amount", func(r *SIPCreateRequest) { r.Amount = 0 }, []error{ErrInvalidAmount}},
+		{"unknown frequency", func(r *SIPCreateRequest) { r.Frequency = "Hourly" }, []error{ErrInvalidFrequency}},
+		{"missing start date", func(r *SIPCreateRequest) { r.StartDate = time.Time{} }, []error{ErrEmptyStartDate}},
+		{"missing mandate", func(r *SIPCreateRequest) { r.MandateID = " " }, []error{ErrEmptyMandateID}},
+		{"everything", func(r *SIPCreateRequest) { *r = SIPCreateRequest{} }, []error{
+			ErrEmptyFundCode, ErrInvalidAmount, ErrInvalidFrequency, ErrEmptyStartDate, ErrEmptyMandateID,
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validSIPRequest()
+			tt.modify(&req)
+			err := req.Validate()
+			if err == nil {
+				t.Fatal("Validate() error = nil")
+			}
+			for _, want := range tt.want {
+				if !errors.Is(err, want) {
+					t.Errorf("Validate() error = %v, want %v", err, want)
+				}
+			}
+			if got := len(err.(interface{ Unwrap() []error }).Unwrap()); got != len(tt.want) {
+				t.Errorf("Validate() reported %d violations, want %d: %v", got, len(tt.want), err)
+			}
+		})
+	}
+}
+
+func TestCreateSIP(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/v3/sips.json" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var payload sipCreatePayload
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode payload: %v", err)
+		}
+		want := sipCreatePayload{
+			AMCAmfiCodeTo: "INF179K01BE2",
+			Amount:        5000,
+			Frequency:     "Monthly",
+			StartDate:     "2025-01-05",
+			MandateID:     "MNDT123",
+		}
+		if payload != want {
+			t.Errorf("payload = %+v, want %+v", payload, want)
+		}
+		w.Write([]byte(`{"id":9876,"amc_amfi_code_to":"INF179K01BE2","amount":5000,"frequency":"Monthly","start_date":"2025-01-05","state":"active"}`))
+	}, WithTransactionsEnabled())
+
+	sip, err := client.CreateSIP(context.Background(), validSIPRequest())
+	if err != nil {
+		t.Fatalf("CreateSIP() error = %v", err)
+	}
+	if sip.ID != 9876 || sip.State != SIPStateActive || sip.Amount != 5000 {
+		t.Errorf("unexpected SIP: %+v", sip)
+	}
+}
+
+func TestCancelSIP(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" || r.URL.Path != "/api/v3/sips/9876.json" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Write([]byte(`{"status":"success"}`))
+	}, WithTransactionsEnabled())
+
+	if err := client.CancelSIP(context.Background(), 9876); err != nil {
+		t.Fatalf("CancelSIP() error = %v", err)
+	}
+}
@@ -0,0 +1,168 @@
+package kuvera
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Snapshot bundles the portfolio, holdings and gold price fetched together by GetAll.
+type Snapshot struct {
+	// CapturedAt is when the snapshot was taken
+	CapturedAt time.Time `json:"captured_at"`
+	// Portfolio is the portfolio summary
+	Portfolio *PortfolioResponse `json:"portfolio"`
+	// Holdings are the detailed holdings per fund
+	Holdings *HoldingsResponse `json:"holdings"`
+	// Gold is the current gold price
+	Gold *GoldPriceResponse `json:"gold"`
+
+	// MaskFolios makes WriteJSON and WriteHoldingsCSV mask folio numbers to
+	// their last four characters, for sharing exports. The holdings themselves
+	// keep the full numbers. GetAll sets it for clients constructed with
+	// WithMaskFolios.
+	MaskFolios bool `json:"-"`
+}
+
+// WithMaskFolios masks folio numbers in the exports of snapshots taken by
+// GetAll, so that reports can be shared without revealing them; see
+// Snapshot.MaskFolios. Holding.String always masks folio numbers.
+func WithMaskFolios() ClientOption {
+	return func(c *clientConfig) {
+		c.maskFolios = true
+	}
+}
+
+// GetAll fetches the portfolio, holdings and gold price concurrently and
+// returns them as a single Snapshot.
+//
+// If any request fails, the others are cancelled and the first error is
+// returned. All requests have finished by the time GetAll returns. The user
+// must be authenticated (logged in) before calling this method.
+//
+// Example:
+//
+//	snapshot, err := client.GetAll(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Portfolio: ₹%.2f across %d funds\n", snapshot.Portfolio.Data.CurrentValue, len(*snapshot.Holdings))
+func (c *Client) GetAll(ctx context.Context) (*Snapshot, error) {
+	if c.token() == "" {
+		return nil, ErrNotAuthenticated
+	}
+
+	ctx, cancel := context.WithCancel(contextOrBackground(ctx))
+	defer cancel()
+
+	snapshot := &Snapshot{CapturedAt: c.clock.Now(), MaskFolios: c.maskFolios}
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	fetch := func(name string, f func() error) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := f(); err != nil {
+				once.Do(func() {
+					firstErr = fmt.Errorf("snapshot %s: %w", name, err)
+					cancel()
+				})
+			}
+		}()
+	}
+
+	fetch("portfolio", func() (err error) {
+		snapshot.Portfolio, err = c.GetPortfolio(ctx)
+		return err
+	})
+	fetch("holdings", func() (err error) {
+		snapshot.Holdings, err = c.GetHoldings(ctx)
+		return err
+	})
+	fetch("gold", func() (err error) {
+		snapshot.Gold, err = c.GetGoldPrice(ctx)
+		return err
+	})
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return snapshot, nil
+}
+
+// WriteJSON writes the snapshot to w as an indented JSON document, suitable
+// for archiving and diffing. Map keys, including the fund codes of the
+// holdings, are written in sorted order, so the same snapshot always produces
+// the same bytes. Folio numbers are masked if MaskFolios is set.
+func (s *Snapshot) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(s.exported()); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	return nil
+}
+
+// WriteHoldingsCSV writes one CSV row per holding in the snapshot, with the
+// columns fund_code, folio_number, category, units and allotted_amount, sorted
+// by fund code. Folio numbers are masked if MaskFolios is set.
+func (s *Snapshot) WriteHoldingsCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"fund_code", "folio_number", "category", "units", "allotted_amount"})
+	if holdings := s.exported().Holdings; holdings != nil {
+		for _, code := range holdings.FundCodes() {
+			for _, h := range (*holdings)[code] {
+				cw.Write([]string{
+					code,
+					h.FolioNumber,
+					string(h.KuveraCategory),
+					strconv.FormatFloat(h.Units, 'f', -1, 64),
+					strconv.FormatFloat(h.AllottedAmount, 'f', -1, 64),
+				})
+			}
+		}
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("failed to write holdings: %w", err)
+	}
+	return nil
+}
+
+// exported returns the snapshot as it should be written: s itself, or a copy
+// with masked folio numbers if MaskFolios is set.
+func (s *Snapshot) exported() *Snapshot {
+	if !s.MaskFolios || s.Holdings == nil {
+		return s
+	}
+
+	masked := *s
+	holdings := make(HoldingsResponse, len(*s.Holdings))
+	for code, fundHoldings := range *s.Holdings {
+		copied := make([]Holding, len(fundHoldings))
+		for i, h := range fundHoldings {
+			h.FolioNumber = maskFolio(h.FolioNumber)
+			if h.SIPs != nil {
+				sips := make([]SIPDetail, len(h.SIPs))
+				for j, sip := range h.SIPs {
+					sip.FolioNo = maskFolio(sip.FolioNo)
+					sips[j] = sip
+				}
+				h.SIPs = sips
+			}
+			copied[i] = h
+		}
+		holdings[code] = copied
+	}
+	masked.Holdings = &holdings
+	return &masked
+}
@@ -0,0 +1,177 @@
+package kuvera
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func snapshotHandler(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v5/portfolio/returns.json":
+			w.Write([]byte(`{"status":"success","data":{"current_value":150000,"invested":120000,"fixed_deposit":{"total_invested":"5000"}}}`))
+		case "/api/v3/portfolio/holdings.json":
+			w.Write([]byte(`{
+				"ZFUND":[{"folioNumber":"111","units":10,"kuvera_category":"Equity"}],
+				"AFUND":[{"folioNumber":"222","units":20,"kuvera_category":"Debt"}],
+				"MFUND":[{"folioNumber":"333","units":30,"kuvera_category":"Hybrid"}]
+			}`))
+		case "/api/v3/gold/current_price.json":
+			w.Write([]byte(`{"current_gold_price":{"buy":6150.5,"sell":5990}}`))
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+}
+
+func TestGetAll(t *testing.T) {
+	clk := newFakeClock()
+	client := newTestClient(t, snapshotHandler(t), withClock(clk))
+
+	snapshot, err := client.GetAll(context.Background())
+	if err != nil {
+		t.Fatalf("GetAll() error = %v", err)
+	}
+	if !snapshot.CapturedAt.Equal(clk.Now()) {
+		t.Errorf("CapturedAt = %v, want %v", snapshot.CapturedAt, clk.Now())
+	}
+	if snapshot.Portfolio.Data.CurrentValue != 150000 {
+		t.Errorf("CurrentValue = %v, want 150000", snapshot.Portfolio.Data.CurrentValue)
+	}
+	if len(*snapshot.Holdings) != 3 {
+		t.Errorf("got %d funds, want 3", len(*snapshot.Holdings))
+	}
+	if snapshot.Gold.CurrentGoldPrice.Buy != 6150.5 {
+		t.Errorf("Buy = %v, want 6150.5", snapshot.Gold.CurrentGoldPrice.Buy)
+	}
+}
+
+func TestGetAllError(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v3/portfolio/holdings.json" {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{}`))
+			return
+		}
+		snapshotHandler(t)(w, r)
+	})
+
+	snapshot, err := client.GetAll(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "holdings") {
+		t.Errorf("GetAll() error = %v, want holdings error", err)
+	}
+	if snapshot != nil {
+		t.Errorf("GetAll() snapshot = %+v, want nil", snapshot)
+	}
+}
+
+func TestSnapshotWriteJSON(t *testing.T) {
+	client := newTestClient(t, snapshotHandler(t), withClock(newFakeClock()))
+	snapshot, err := client.GetAll(context.Background())
+	if err != nil {
+		t.Fatalf("GetAll() error = %v", err)
+	}
+
+	var first, second bytes.Buffer
+	if err := snapshot.WriteJSON(&first); err != nil {
+		t.Fatalf("WriteJSON() error = %v", err)
+	}
+	if err := snapshot.WriteJSON(&second); err != nil {
+		t.Fatalf("WriteJSON() error = %v", err)
+	}
+	if !bytes.Equal(first.Bytes(), second.Bytes()) {
+		t.Error("WriteJSON() output is not byte-stable")
+	}
+
+	out := first.String()
+	if !strings.Contains(out, `"captured_at": "2024-01-01T09:00:00Z"`) {
+		t.Errorf("missing captured_at in output:\n%s", out)
+	}
+	a, m, z := strings.Index(out, `"AFUND"`), strings.Index(out, `"MFUND"`), strings.Index(out, `"ZFUND"`)
+	if a < 0 || !(a < m && m < z) {
+		t.Errorf("holdings are not sorted by fund code:\n%s", out)
+	}
+
+	var decoded Snapshot
+	if err := json.Unmarshal(first.Bytes(), &decoded); err != nil {
+		t.Fatalf("failed to decode snapshot: %v", err)
+	}
+	if !reflect.DeepEqual(&decoded, snapshot) {
+		t.Errorf("round trip mismatch:\ngot  %+v\nwant %+v", decoded, *snapshot)
+	}
+}
+
+func TestSnapshotMaskFolios(t *testing.T) {
+	holdings := HoldingsResponse{
+		"BFUND": {{FolioNumber: "91012345678", KuveraCategory: CategoryDebt, Units: 5, AllottedAmount: 500}},
+		"AFUND": {{FolioNumber: "1234567/89", KuveraCategory: CategoryEquity, Units: 12.5, AllottedAmount: 1000,
+			SIPs: []SIPDetail{{FolioNo: "1234567/89"}}}},
+	}
+
+	for _, mask := range []bool{false, true} {
+		snapshot := &Snapshot{Holdings: &holdings, MaskFolios: mask}
+
+		var csv, js bytes.Buffer
+		if err := snapshot.WriteHoldingsCSV(&csv); err != nil {
+			t.Fatalf("WriteHoldingsCSV() error = %v", err)
+		}
+		if err := snapshot.WriteJSON(&js); err != nil {
+			t.Fatalf("WriteJSON() error = %v", err)
+		}
+
+		wantCSV := "fund_code,folio_number,category,units,allotted_amount\n" +
+			"AFUND,1234567/89,Equity,12.5,1000\n" +
+			"BFUND,91012345678,Debt,5,500\n"
+		wantFolios := []string{"1234567/89", "91012345678"}
+		if mask {
+			wantCSV = strings.NewReplacer("1234567/89", "******7/89", "91012345678", "*******5678").Replace(wantCSV)
+			wantFolios = []string{"******7/89", "*******5678"}
+		}
+		if csv.String() != wantCSV {
+			t.Errorf("MaskFolios=%t: WriteHoldingsCSV() =\n%s\nwant\n%s", mask, csv.String(), wantCSV)
+		}
+		for _, folio := range wantFolios {
+			if !strings.Contains(js.String(), `"folioNumber": "`+folio+`"`) {
+				t.Errorf("MaskFolios=%t: WriteJSON() output lacks folio %s:\n%s", mask, folio, js.String())
+			}
+		}
+		if got := strings.Contains(js.String(), `"folio_no": "1234567/89"`); got == mask {
+			t.Errorf("MaskFolios=%t: WriteJSON() SIP folio in full = %t:\n%s", mask, got, js.String())
+		}
+	}
+
+	// Holding.String masks regardless, and the holdings keep their raw folios
+	if got := holdings["BFUND"][0].String(); !strings.HasPrefix(got, "Folio *******5678 ") {
+		t.Errorf("String() = %q, want a masked folio", got)
+	}
+	if holdings["AFUND"][0].SIPs[0].FolioNo != "1234567/89" {
+		t.Errorf("SIP FolioNo = %q, want the raw value kept", holdings["AFUND"][0].SIPs[0].FolioNo)
+	}
+	if holdings["BFUND"][0].FolioNumber != "91012345678" {
+		t.Errorf("FolioNumber = %q, want the raw value kept", holdings["BFUND"][0].FolioNumber)
+	}
+}
+
+func TestGetAllWithMaskFolios(t *testing.T) {
+	for _, mask := range []bool{false, true} {
+		var options []ClientOption
+		if mask {
+			options = append(options, WithMaskFolios())
+		}
+		client := newTestClient(t, snapshotHandler(t), options...)
+
+		snapshot, err := client.GetAll(context.Background())
+		if err != nil {
+			t.Fatalf("GetAll() error = %v", err)
+		}
+		if snapshot.MaskFolios != mask {
+			t.Errorf("MaskFolios = %t, want %t", snapshot.MaskFolios, mask)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package kuvera
+
+import (
+	"io"
+	"maps"
+	"net/http"
+	"sync"
+)
+
+// ClientStats contains counters describing the requests made by a Client.
+type ClientStats struct {
+	// Requests is the number of API calls made, counting retries of a call once
+	Requests int64
+	// Successes is the number of calls answered with a 2xx status, or with a
+	// status declared expected with WithExpectedStatus
+	Successes int64
+	// Failures is the number of calls that failed, with or without a response
+	Failures int64
+	// FailuresByStatus counts failed calls by HTTP status code; calls that got
+	// no response at all (e.g. network errors) are counted under 0
+	FailuresByStatus map[int]int64
+	// BytesRead is the total number of response body bytes read
+	BytesRead int64
+}
+
+// clientStats accumulates ClientStats. It is safe for concurrent use.
+type clientStats struct {
+	mu    sync.Mutex
+	stats ClientStats
+}
+
+// record counts a call that produced resp and err; success reports whether
+// the status of resp counts as a success.
+func (s *clientStats) record(resp *http.Response, err error, success func(status int) bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stats.Requests++
+	if err == nil && success(resp.StatusCode) {
+		s.stats.Successes++
+		return
+	}
+
+	status := 0
+	if err == nil {
+		status = resp.StatusCode
+	}
+	s.stats.Failures++
+	if s.stats.FailuresByStatus == nil {
+		s.stats.FailuresByStatus = make(map[int]int64)
+	}
+	s.stats.FailuresByStatus[status]++
+}
+
+// addBytes counts n bytes of response body read.
+func (s *clientStats) addBytes(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stats.BytesRead += int64(n)
+}
+
+// countingBody counts the bytes read from a response body.
+type countingBody struct {
+	io.ReadCloser
+	stats *clientStats
+}
+
+func (b *countingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if n > 0 {
+		b.stats.addBytes(n)
+	}
+	return n, err
+}
+
+// Stats returns a copy of the client's request counters, for example to serve
+// from a debug handler.
+func (c *Client) Stats() ClientStats {
+	c.stats.mu.Lock()
+	defer c.stats.mu.Unlock()
+
+	stats := c.stats.stats
+	stats.FailuresByStatus = maps.Clone(stats.FailuresByStatus)
+	if stats.FailuresByStatus == nil {
+		stats.FailuresByStatus = make(map[int]int64)
+	}
+	return stats
+}
+
+// ResetStats sets all request counters back to zero.
+func (c *Client) ResetStats() {
+	c.stats.mu.Lock()
+	defer c.stats.mu.Unlock()
+	c.stats.stats = ClientStats{}
+}
@@ -0,0 +1,67 @@
+package kuvera
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestClientStats(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v3/goals.json":
+			w.Write([]byte(`{"status":"success"}`))
+		case "/api/v3/watchlist.json":
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{}`))
+		}
+	})
+
+	ctx := context.Background()
+	client.GetGoals(ctx)
+	client.GetGoals(ctx)
+	client.GetWatchlist(ctx)
+	client.GetDividends(ctx)
+
+	// A request that never reaches a server
+	offline := NewClient(WithBaseURL("http://127.0.0.1:0")).(*Client)
+	offline.accessToken = "test-token"
+	offline.GetGoals(ctx)
+
+	want := ClientStats{
+		Requests:         4,
+		Successes:        2,
+		Failures:         2,
+		FailuresByStatus: map[int]int64{http.StatusServiceUnavailable: 1, http.StatusNotFound: 1},
+		BytesRead:        int64(2*len(`{"status":"success"}`) + 2*len(`{}`)),
+	}
+	if got := client.Stats(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Stats() = %+v, want %+v", got, want)
+	}
+	if got := offline.Stats(); got.Failures != 1 || got.FailuresByStatus[0] != 1 {
+		t.Errorf("Stats() = %+v, want one failure without a response", got)
+	}
+
+	client.ResetStats()
+	if got := client.Stats(); !reflect.DeepEqual(got, ClientStats{FailuresByStatus: map[int]int64{}}) {
+		t.Errorf("Stats() after reset = %+v, want zero counters", got)
+	}
+}
+
+func TestClientStatsExpectedStatus(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(`{"status":"success"}`))
+	}, WithExpectedStatus(http.StatusConflict))
+
+	if _, err := client.GetGoals(context.Background()); err != nil {
+		t.Fatalf("GetGoals() error = %v", err)
+	}
+	if got := client.Stats(); got.Successes != 1 || got.Failures != 0 {
+		t.Errorf("Stats() = %+v, want the expected status counted as a success", got)
+	}
+}
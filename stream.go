@@ -0,0 +1,226 @@
+package kuvera
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+// ErrStreamStalled is returned when no response data arrives for longer than
+// the idle timeout set with WithStreamIdleTimeout.
+var ErrStreamStalled = errors.New("response stream stalled")
+
+// errorPrefixBytes is how much of a streamed body is kept for parse error messages.
+const errorPrefixBytes = 512
+
+// decodeResponse is a streaming variant of handleResponse for large responses.
+//
+// Successful responses are decoded directly from the body instead of being
+// buffered in full first. Only a bounded prefix of the body is retained to
+// describe parse failures. Error responses, and responses that need the raw
+// body for validation or a WithResponseTransform hook, are delegated to
+// handleResponse. As there, a successful response without a body leaves
+// result untouched. Result types implementing streamDecoder are decoded piece
+// by piece to keep peak memory low.
+func (c *Client) decodeResponse(resp *http.Response, result interface{}, operation string) error {
+	if !c.isSuccessStatus(resp.StatusCode) {
+		return c.handleResponse(resp, result, operation)
+	}
+	if _, ok := result.(responseValidator); ok && c.validateResponses {
+		return c.handleResponse(resp, result, operation)
+	}
+	if c.responseTransform != nil {
+		return c.handleResponse(resp, result, operation)
+	}
+	defer resp.Body.Close()
+
+	prefix := &prefixWriter{max: errorPrefixBytes}
+	body := io.TeeReader(&maxBytesReader{r: resp.Body, remaining: c.maxBodyBytes, limit: c.maxBodyBytes}, prefix)
+
+	dec := json.NewDecoder(body)
+	var err error
+	if sd, ok := result.(streamDecoder); ok {
+		err = sd.decodeStream(dec)
+	} else {
+		err = dec.Decode(result)
+	}
+	if err != nil {
+		if errors.Is(err, ErrResponseTooLarge) {
+			return err
+		}
+		// Like handleResponse, accept a successful response without a body
+		// and leave result untouched
+		if errors.Is(err, io.EOF) && len(bytes.TrimSpace(prefix.buf)) == 0 {
+			return nil
+		}
+		return fmt.Errorf("failed to parse response (body prefix: %s): %w", prefix.buf, err)
+	}
+
+	// Drain a little of any trailing data so the connection can be reused
+	io.Copy(io.Discard, io.LimitReader(resp.Body, errorPrefixBytes))
+
+	return nil
+}
+
+// streamDecoder is implemented by response types that can decode themselves
+// piecewise, so that the decoder never buffers the whole document.
+type streamDecoder interface {
+	decodeStream(dec *json.Decoder) error
+}
+
+// decodeStream decodes the holdings map one fund at a time. A plain
+// json.Decoder.Decode would buffer the entire object before decoding it.
+func (h *HoldingsResponse) decodeStream(dec *json.Decoder) error {
+	return decodeHoldingsStream(dec, h, nil)
+}
+
+// decodeHoldingsStream decodes the holdings map from dec into h one fund at a
+// time. If failed is not nil, a fund whose holdings cannot be decoded is
+// skipped and its error recorded in failed under its fund code; otherwise the
+// first such error is returned. Malformed JSON always fails the whole decode.
+func decodeHoldingsStream(dec *json.Decoder, h *HoldingsResponse, failed map[string]error) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		*h = nil
+		return nil
+	}
+	if tok != json.Delim('{') {
+		return fmt.Errorf("expected holdings object, got %v", tok)
+	}
+
+	holdings := make(HoldingsResponse)
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		fundCode, ok := tok.(string)
+		if !ok {
+			return fmt.Errorf("expected fund code, got %v", tok)
+		}
+
+		var fundHoldings []Holding
+		if failed == nil {
+			if err := dec.Decode(&fundHoldings); err != nil {
+				return err
+			}
+			holdings[fundCode] = fundHoldings
+			continue
+		}
+
+		// Buffer the fund so that a type mismatch in it leaves the decoder
+		// positioned at the next fund
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return err
+		}
+		if err := json.Unmarshal(raw, &fundHoldings); err != nil {
+			failed[fundCode] = err
+			continue
+		}
+		holdings[fundCode] = fundHoldings
+	}
+
+	// Consume the closing brace
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+
+	*h = holdings
+	return nil
+}
+
+// prefixWriter keeps the first max bytes written to it and discards the rest.
+type prefixWriter struct {
+	buf []byte
+	max int
+}
+
+func (w *prefixWriter) Write(p []byte) (int, error) {
+	if room := w.max - len(w.buf); room > 0 {
+		w.buf = append(w.buf, p[:min(room, len(p))]...)
+	}
+	return len(p), nil
+}
+
+// maxBytesReader reads at most limit bytes, failing with ErrResponseTooLarge
+// if the underlying reader has more.
+type maxBytesReader struct {
+	r         io.Reader
+	remaining int64
+	limit     int64
+}
+
+func (m *maxBytesReader) Read(p []byte) (int, error) {
+	if m.remaining <= 0 {
+		var probe [1]byte
+		if n, err := m.r.Read(probe[:]); n == 0 {
+			return 0, err
+		}
+		return 0, fmt.Errorf("%w: limit is %d bytes", ErrResponseTooLarge, m.limit)
+	}
+	if int64(len(p)) > m.remaining {
+		p = p[:m.remaining]
+	}
+	n, err := m.r.Read(p)
+	m.remaining -= int64(n)
+	return n, err
+}
+
+// WithStreamIdleTimeout aborts a request if its response body stops making
+// progress: the deadline is reset every time data arrives, so a slow but
+// steady download of a large response, such as the holdings of a big account,
+// can complete while a stalled connection is still cut off. A stall fails with
+// an error wrapping ErrStreamStalled.
+//
+// The overall limit set with WithTimeout still applies, so raise it (or set it
+// to zero) for downloads expected to take longer.
+func WithStreamIdleTimeout(timeout time.Duration) ClientOption {
+	return func(c *clientConfig) {
+		c.streamIdleTimeout = timeout
+	}
+}
+
+// idleTimeoutBody cancels its request if no data is read from the body for
+// longer than timeout.
+type idleTimeoutBody struct {
+	io.ReadCloser
+	timeout time.Duration
+	timer   *time.Timer
+	stalled atomic.Bool
+}
+
+// newIdleTimeoutBody wraps body, calling cancel once it stalls.
+func newIdleTimeoutBody(body io.ReadCloser, timeout time.Duration, cancel context.CancelFunc) *idleTimeoutBody {
+	b := &idleTimeoutBody{ReadCloser: body, timeout: timeout}
+	b.timer = time.AfterFunc(timeout, func() {
+		b.stalled.Store(true)
+		cancel()
+	})
+	return b
+}
+
+func (b *idleTimeoutBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if b.stalled.Load() {
+		return n, fmt.Errorf("%w: no data received for %v", ErrStreamStalled, b.timeout)
+	}
+	if n > 0 {
+		b.timer.Reset(b.timeout)
+	}
+	return n, err
+}
+
+func (b *idleTimeoutBody) Close() error {
+	b.timer.Stop()
+	return b.ReadCloser.Close()
+}
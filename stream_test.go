@@ -0,0 +1,192 @@
+package kuvera
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// syntheticHoldings returns a holdings payload with the given number of funds.
+func syntheticHoldings(funds int) []byte {
+	holdings := make(HoldingsResponse, funds)
+	for i := 0; i < funds; i++ {
+		orders := make([]OrderDetail, 24)
+		for j := range orders {
+			orders[j] = OrderDetail{Amount: 5000, NAV: 42.5 + float64(j), Units: 117.6, OrderDate: "2023-01-05"}
+		}
+		holdings[fmt.Sprintf("FUND%04d-GR", i)] = []Holding{{
+			FolioNumber:    fmt.Sprintf("9101%06d", i),
+			AllottedAmount: 120000,
+			Units:          2822.4,
+			KuveraCategory: CategoryEquity,
+			OrderDetails:   orders,
+			ValidFlag:      ValidFlagValid,
+			Source:         "kuvera",
+		}}
+	}
+	body, _ := json.Marshal(holdings)
+	return body
+}
+
+func newBodyResponse(body []byte) *http.Response {
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))}
+}
+
+func TestGetHoldingsStreaming(t *testing.T) {
+	payload := syntheticHoldings(50)
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write(payload)
+	})
+
+	holdings, err := client.GetHoldings(context.Background())
+	if err != nil {
+		t.Fatalf("GetHoldings() error = %v", err)
+	}
+	if len(*holdings) != 50 {
+		t.Errorf("got %d funds, want 50", len(*holdings))
+	}
+}
+
+func TestDecodeResponseParseErrorPrefix(t *testing.T) {
+	client := NewClient().(*Client)
+	body := append([]byte(`{"FUND":[{"units":"oops"`), bytes.Repeat([]byte(" "), 4096)...)
+
+	var holdings HoldingsResponse
+	err := client.decodeResponse(newBodyResponse(body), &holdings, "holdings")
+	if err == nil {
+		t.Fatal("decodeResponse() error = nil, want parse error")
+	}
+	if !strings.Contains(err.Error(), `{"FUND":[{"units":"oops"`) {
+		t.Errorf("error %q does not include the body prefix", err)
+	}
+	if len(err.Error()) > 2*errorPrefixBytes {
+		t.Errorf("error message is %d bytes, want it bounded", len(err.Error()))
+	}
+}
+
+func TestGetHoldingsEmptyBody(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		status int
+		body   string
+	}{
+		{"empty", http.StatusOK, ""},
+		{"whitespace", http.StatusOK, " \n"},
+		{"no content", http.StatusNoContent, ""},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			})
+
+			// The streaming path must agree with the buffered one
+			if _, err := client.GetPortfolio(context.Background()); err != nil {
+				t.Errorf("GetPortfolio() error = %v", err)
+			}
+			holdings, err := client.GetHoldings(context.Background())
+			if err != nil {
+				t.Fatalf("GetHoldings() error = %v", err)
+			}
+			if len(*holdings) != 0 {
+				t.Errorf("GetHoldings() = %v, want no holdings", *holdings)
+			}
+		})
+	}
+}
+
+func TestDecodeResponseTruncated(t *testing.T) {
+	client := NewClient().(*Client)
+
+	var holdings HoldingsResponse
+	if err := client.decodeResponse(newBodyResponse([]byte(`{"FUND":[`)), &holdings, "holdings"); err == nil {
+		t.Error("decodeResponse() error = nil, want parse error for a truncated body")
+	}
+}
+
+func TestDecodeResponseTooLarge(t *testing.T) {
+	client := NewClient(WithMaxResponseBytes(1024)).(*Client)
+
+	var holdings HoldingsResponse
+	err := client.decodeResponse(newBodyResponse(syntheticHoldings(10)), &holdings, "holdings")
+	if !errors.Is(err, ErrResponseTooLarge) {
+		t.Errorf("decodeResponse() error = %v, want %v", err, ErrResponseTooLarge)
+	}
+}
+
+func BenchmarkHoldingsDecode(b *testing.B) {
+	payload := syntheticHoldings(500)
+	client := NewClient().(*Client)
+
+	b.Run("buffered", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(payload)))
+		for i := 0; i < b.N; i++ {
+			var holdings HoldingsResponse
+			if err := client.handleResponse(newBodyResponse(payload), &holdings, "holdings"); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("streaming", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(payload)))
+		for i := 0; i < b.N; i++ {
+			var holdings HoldingsResponse
+			if err := client.decodeResponse(newBodyResponse(payload), &holdings, "holdings"); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func TestWithStreamIdleTimeoutSlowStream(t *testing.T) {
+	body := syntheticHoldings(4)
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		// Trickle the body out over several idle timeouts in total
+		chunk := len(body)/8 + 1
+		for start := 0; start < len(body); start += chunk {
+			w.Write(body[start:min(start+chunk, len(body))])
+			w.(http.Flusher).Flush()
+			time.Sleep(30 * time.Millisecond)
+		}
+	}, WithStreamIdleTimeout(100*time.Millisecond))
+
+	holdings, err := client.GetHoldings(context.Background())
+	if err != nil {
+		t.Fatalf("GetHoldings() error = %v", err)
+	}
+	if len(*holdings) != 4 {
+		t.Errorf("got %d funds, want 4", len(*holdings))
+	}
+}
+
+func TestWithStreamIdleTimeoutStalledStream(t *testing.T) {
+	release := make(chan struct{})
+	t.Cleanup(func() { close(release) })
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"FUND1":[`))
+		w.(http.Flusher).Flush()
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}, WithStreamIdleTimeout(100*time.Millisecond))
+
+	start := time.Now()
+	_, err := client.GetHoldings(context.Background())
+	if !errors.Is(err, ErrStreamStalled) {
+		t.Fatalf("GetHoldings() error = %v, want %v", err, ErrStreamStalled)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("stall detected after %v, want about 100ms", elapsed)
+	}
+}
@@ -0,0 +1,45 @@
+package kuvera
+
+import (
+	"crypto/tls"
+)
+
+// WithInsecureSkipVerify disables TLS certificate verification.
+//
+// This is intended for debugging only, e.g. when inspecting traffic through a
+// local proxy such as mitmproxy. Never use it in production: it makes the
+// connection vulnerable to interception. A warning is logged via the client's
+// logger when it is enabled.
+//
+// It applies to the client set with WithHTTPClient regardless of option order.
+// The provided client is copied rather than modified. If that client uses a
+// custom RoundTripper other than *http.Transport, the setting cannot be applied
+// and a warning is logged instead.
+func WithInsecureSkipVerify() ClientOption {
+	return func(c *clientConfig) {
+		c.insecureSkipVerify = true
+	}
+}
+
+// applyInsecureSkipVerify returns a copy of the configured HTTP client whose
+// transport skips TLS verification.
+func (c *clientConfig) applyInsecureSkipVerify() {
+	transport, ok := c.cloneTransport()
+	if !ok {
+		if c.logger != nil {
+			c.logger.Warn("kuvera: cannot disable TLS verification on a custom transport")
+		}
+		return
+	}
+
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{}
+	}
+	transport.TLSClientConfig.InsecureSkipVerify = true
+
+	c.setTransport(transport)
+
+	if c.logger != nil {
+		c.logger.Warn("kuvera: TLS certificate verification is disabled; use only for debugging")
+	}
+}
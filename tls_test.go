@@ -0,0 +1,67 @@
+package kuvera
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTLSServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":"success"}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestWithInsecureSkipVerify(t *testing.T) {
+	server := newTLSServer(t)
+
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, nil))
+	client := NewClient(WithBaseURL(server.URL), WithInsecureSkipVerify(), WithLogger(logger)).(*Client)
+	client.accessToken = "test-token"
+
+	if err := client.Ping(context.Background()); err != nil {
+		t.Fatalf("Ping() error = %v", err)
+	}
+	if !strings.Contains(logs.String(), "TLS certificate verification is disabled") {
+		t.Errorf("expected a warning to be logged, got %q", logs.String())
+	}
+}
+
+func TestWithInsecureSkipVerifyDisabled(t *testing.T) {
+	server := newTLSServer(t)
+
+	client := NewClient(WithBaseURL(server.URL)).(*Client)
+	client.accessToken = "test-token"
+
+	if err := client.Ping(context.Background()); err == nil {
+		t.Error("Ping() error = nil, want certificate verification error")
+	}
+}
+
+func TestWithInsecureSkipVerifyCustomHTTPClient(t *testing.T) {
+	server := newTLSServer(t)
+
+	custom := &http.Client{Timeout: 5 * time.Second}
+	// Option order must not matter
+	client := NewClient(WithBaseURL(server.URL), WithInsecureSkipVerify(), WithHTTPClient(custom)).(*Client)
+	client.accessToken = "test-token"
+
+	if err := client.Ping(context.Background()); err != nil {
+		t.Fatalf("Ping() error = %v", err)
+	}
+	if client.httpClient.Timeout != 5*time.Second {
+		t.Errorf("Timeout = %v, want the provided client's 5s", client.httpClient.Timeout)
+	}
+	if custom.Transport != nil {
+		t.Error("the provided http.Client was modified")
+	}
+}
@@ -0,0 +1,122 @@
+package kuvera
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// WithTokenRefresh refreshes the access token before it expires.
+//
+// Before each request made with a token, the client checks the token's JWT
+// "exp" claim. If the token expires within threshold, refresh is called and
+// the token it returns is used from then on. This supports custom refresh
+// flows, such as fetching tokens from an external secret manager, without
+// giving the client any credentials.
+//
+// Concurrent requests share a single refresh: only one call to refresh runs at
+// a time, and requests waiting on it use its result. Tokens without a readable
+// "exp" claim are never refreshed. If refresh fails, the request is not sent
+// and the error is returned.
+func WithTokenRefresh(threshold time.Duration, refresh func(ctx context.Context) (string, error)) ClientOption {
+	return func(c *clientConfig) {
+		c.tokenRefresh = &tokenRefresher{threshold: threshold, refresh: refresh}
+	}
+}
+
+// tokenRefresher holds the WithTokenRefresh configuration. Its mutex
+// serializes refreshes.
+type tokenRefresher struct {
+	threshold time.Duration
+	refresh   func(ctx context.Context) (string, error)
+	mu        sync.Mutex
+}
+
+// token returns the current access token.
+func (c *Client) token() string {
+	c.tokenMu.RLock()
+	defer c.tokenMu.RUnlock()
+	return c.accessToken
+}
+
+// setToken replaces the access token.
+func (c *Client) setToken(token string) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+	c.accessToken = token
+}
+
+// session returns the current session ID.
+func (c *Client) session() string {
+	c.tokenMu.RLock()
+	defer c.tokenMu.RUnlock()
+	return c.sessionID
+}
+
+// setSession replaces the session ID.
+func (c *Client) setSession(sessionID string) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+	c.sessionID = sessionID
+}
+
+// refreshTokenIfExpiring refreshes the access token if WithTokenRefresh is
+// configured and the token expires within the threshold.
+func (c *Client) refreshTokenIfExpiring(ctx context.Context) error {
+	r := c.tokenRefresh
+	if r == nil || !c.tokenExpiring(c.token()) {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Another request may have refreshed the token while this one waited
+	if !c.tokenExpiring(c.token()) {
+		return nil
+	}
+
+	token, err := r.refresh(ctx)
+	if err != nil {
+		return fmt.Errorf("token refresh failed: %w", err)
+	}
+	c.setToken(token)
+	return nil
+}
+
+// tokenExpiring reports whether token has an expiry within the refresh threshold.
+func (c *Client) tokenExpiring(token string) bool {
+	if token == "" {
+		return false
+	}
+	exp, ok := jwtExpiry(token)
+	if !ok {
+		return false
+	}
+	return exp.Sub(c.clock.Now()) <= c.tokenRefresh.threshold
+}
+
+// jwtExpiry returns the time of a JWT's "exp" claim. The signature is not
+// verified; the claim is only used to decide when to refresh.
+func jwtExpiry(token string) (time.Time, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, false
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	var claims struct {
+		Exp *float64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == nil {
+		return time.Time{}, false
+	}
+	return time.Unix(int64(*claims.Exp), 0), true
+}
@@ -0,0 +1,137 @@
+package kuvera
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testJWT returns an unsigned JWT expiring at exp.
+func testJWT(exp time.Time) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"sub":"user","exp":%d}`, exp.Unix())))
+	return header + "." + payload + ".signature"
+}
+
+func TestJWTExpiry(t *testing.T) {
+	exp := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	got, ok := jwtExpiry(testJWT(exp))
+	if !ok || !got.Equal(exp) {
+		t.Errorf("jwtExpiry() = %v, %t, want %v", got, ok, exp)
+	}
+
+	for _, token := range []string{"", "opaque-token", "a.b.c", "a." + base64.RawURLEncoding.EncodeToString([]byte(`{}`)) + ".c"} {
+		if _, ok := jwtExpiry(token); ok {
+			t.Errorf("jwtExpiry(%q) ok = true, want false", token)
+		}
+	}
+}
+
+func TestWithTokenRefreshConcurrent(t *testing.T) {
+	clk := newFakeClock()
+	expiring := testJWT(clk.Now().Add(30 * time.Second))
+	fresh := testJWT(clk.Now().Add(time.Hour))
+
+	var refreshes atomic.Int32
+	refresh := func(ctx context.Context) (string, error) {
+		refreshes.Add(1)
+		// Give the other requests time to queue up behind this refresh
+		time.Sleep(20 * time.Millisecond)
+		return fresh, nil
+	}
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer "+fresh {
+			t.Errorf("Authorization = %q, want the refreshed token", got)
+		}
+		w.Write([]byte(`{"status":"success"}`))
+	}, withClock(clk), WithTokenRefresh(time.Minute, refresh))
+	client.accessToken = expiring
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.GetGoals(context.Background()); err != nil {
+				t.Errorf("GetGoals() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := refreshes.Load(); n != 1 {
+		t.Errorf("refresh called %d times, want 1", n)
+	}
+}
+
+func TestWithTokenRefreshNotExpiring(t *testing.T) {
+	clk := newFakeClock()
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":"success"}`))
+	}, withClock(clk), WithTokenRefresh(time.Minute, func(ctx context.Context) (string, error) {
+		t.Error("refresh should not be called for a token far from expiry")
+		return "", nil
+	}))
+	client.accessToken = testJWT(clk.Now().Add(time.Hour))
+
+	if _, err := client.GetGoals(context.Background()); err != nil {
+		t.Fatalf("GetGoals() error = %v", err)
+	}
+}
+
+func TestWithTokenRefreshError(t *testing.T) {
+	clk := newFakeClock()
+	errRefresh := errors.New("secret manager unavailable")
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request expected when the refresh fails")
+	}, withClock(clk), WithTokenRefresh(time.Minute, func(ctx context.Context) (string, error) {
+		return "", errRefresh
+	}))
+	client.accessToken = testJWT(clk.Now().Add(-time.Second))
+
+	if _, err := client.GetGoals(context.Background()); !errors.Is(err, errRefresh) {
+		t.Errorf("GetGoals() error = %v, want %v", err, errRefresh)
+	}
+}
+
+// TestLoginConcurrentWithRequests is meant for the race detector: Login
+// replaces the token and session ID while other goroutines send requests.
+func TestLoginConcurrentWithRequests(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v5/users/authenticate.json" {
+			w.Write([]byte(`{"status":"success","token":"jwt","session_id":"sess-new"}`))
+			return
+		}
+		w.Write([]byte(`{"status":"success"}`))
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if _, err := client.Login(context.Background(), "user", "pass"); err != nil {
+				t.Errorf("Login() error = %v", err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if _, err := client.GetGoals(context.Background()); err != nil {
+				t.Errorf("GetGoals() error = %v", err)
+			}
+			_ = client.SessionID()
+		}()
+	}
+	wg.Wait()
+
+	if got := client.SessionID(); got != "sess-new" {
+		t.Errorf("SessionID() = %q, want %q", got, "sess-new")
+	}
+}
@@ -0,0 +1,89 @@
+package kuvera
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// WithTolerantDecoding makes GetHoldings skip funds whose holdings have an
+// unexpected shape instead of failing the whole call, so that one odd fund in
+// a large account does not hide the rest.
+//
+// The funds that decoded are returned together with a *HoldingsDecodeError
+// recording why each skipped fund failed. The response must still be valid
+// JSON: a truncated or malformed body fails as before.
+//
+// Example:
+//
+//	client := kuvera.NewClient(kuvera.WithTolerantDecoding())
+//	// ... login ...
+//	holdings, err := client.GetHoldings(ctx)
+//	var decodeErr *kuvera.HoldingsDecodeError
+//	if errors.As(err, &decodeErr) {
+//		log.Printf("skipped funds: %v", decodeErr)
+//	} else if err != nil {
+//		log.Fatal(err)
+//	}
+func WithTolerantDecoding() ClientOption {
+	return func(c *clientConfig) {
+		c.tolerantDecoding = true
+	}
+}
+
+// HoldingsDecodeError is returned along with the remaining holdings by clients
+// constructed with WithTolerantDecoding when some funds could not be decoded.
+type HoldingsDecodeError struct {
+	// Funds maps the code of each skipped fund to its decode error
+	Funds map[string]error
+}
+
+func (e *HoldingsDecodeError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "skipped %d undecodable funds", len(e.Funds))
+	for i, code := range slices.Sorted(maps.Keys(e.Funds)) {
+		sep := "; "
+		if i == 0 {
+			sep = ": "
+		}
+		fmt.Fprintf(&b, "%s%s: %v", sep, code, e.Funds[code])
+	}
+	return b.String()
+}
+
+// Unwrap returns the errors of the skipped funds.
+func (e *HoldingsDecodeError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Funds))
+	for _, err := range e.Funds {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// tolerantHoldings decodes holdings, skipping funds that fail to decode.
+type tolerantHoldings struct {
+	holdings HoldingsResponse
+	failed   map[string]error
+}
+
+func (t *tolerantHoldings) decodeStream(dec *json.Decoder) error {
+	t.failed = make(map[string]error)
+	return decodeHoldingsStream(dec, &t.holdings, t.failed)
+}
+
+// UnmarshalJSON is used when the body is buffered, such as with a
+// WithResponseTransform hook.
+func (t *tolerantHoldings) UnmarshalJSON(data []byte) error {
+	return t.decodeStream(json.NewDecoder(bytes.NewReader(data)))
+}
+
+// err returns a *HoldingsDecodeError if any fund was skipped.
+func (t *tolerantHoldings) err() error {
+	if len(t.failed) == 0 {
+		return nil
+	}
+	return &HoldingsDecodeError{Funds: t.failed}
+}
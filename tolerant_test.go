@@ -0,0 +1,69 @@
+package kuvera
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const holdingsWithMalformedFund = `{
+	"AFUND": [{"folioNumber": "1", "units": 10}],
+	"BFUND": [{"folioNumber": "2", "units": "lots"}],
+	"CFUND": [{"folioNumber": "3", "units": 5}],
+	"DFUND": {"unexpected": "object"}
+}`
+
+func TestWithTolerantDecoding(t *testing.T) {
+	for _, transform := range []bool{false, true} {
+		options := []ClientOption{WithTolerantDecoding()}
+		if transform {
+			// A transform makes the body buffered rather than streamed
+			options = append(options, WithResponseTransform(func(body []byte) []byte { return body }))
+		}
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(holdingsWithMalformedFund))
+		}, options...)
+
+		holdings, err := client.GetHoldings(context.Background())
+		var decodeErr *HoldingsDecodeError
+		if !errors.As(err, &decodeErr) {
+			t.Fatalf("GetHoldings() error = %v, want *HoldingsDecodeError", err)
+		}
+		if len(decodeErr.Funds) != 2 || decodeErr.Funds["BFUND"] == nil || decodeErr.Funds["DFUND"] == nil {
+			t.Errorf("HoldingsDecodeError.Funds = %v, want BFUND and DFUND", decodeErr.Funds)
+		}
+		if msg := err.Error(); !strings.Contains(msg, "skipped 2 undecodable funds: BFUND: ") || !strings.Contains(msg, "; DFUND: ") {
+			t.Errorf("Error() = %q", msg)
+		}
+
+		if got := holdings.FundCodes(); len(got) != 2 || got[0] != "AFUND" || got[1] != "CFUND" {
+			t.Errorf("FundCodes() = %v, want [AFUND CFUND]", got)
+		}
+		if (*holdings)["CFUND"][0].Units != 5 {
+			t.Errorf("CFUND units = %v, want 5", (*holdings)["CFUND"][0].Units)
+		}
+	}
+}
+
+func TestWithTolerantDecodingAllValid(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"AFUND":[{"units":10}]}`))
+	}, WithTolerantDecoding())
+
+	holdings, err := client.GetHoldings(context.Background())
+	if err != nil || len(*holdings) != 1 {
+		t.Errorf("GetHoldings() = %v, %v", holdings, err)
+	}
+}
+
+func TestStrictDecodingFailsOnMalformedFund(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(holdingsWithMalformedFund))
+	})
+
+	if _, err := client.GetHoldings(context.Background()); err == nil {
+		t.Error("GetHoldings() error = nil, want a decode error")
+	}
+}
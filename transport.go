@@ -0,0 +1,131 @@
+package kuvera
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"slices"
+	"time"
+)
+
+// dialTimeouts holds the fine-grained timeouts set with WithDialTimeouts.
+type dialTimeouts struct {
+	connect        time.Duration
+	tlsHandshake   time.Duration
+	responseHeader time.Duration
+}
+
+// WithDialTimeouts sets separate timeouts for establishing the TCP connection,
+// completing the TLS handshake and waiting for the response headers, so that a
+// slow handshake fails early instead of eating into the overall timeout set
+// with WithTimeout. A zero value leaves the corresponding timeout unset.
+// Keep-alives remain enabled.
+//
+// It applies to the client set with WithHTTPClient regardless of option order.
+// The provided client is copied and its *http.Transport cloned rather than
+// modified, so the timeouts given here take precedence over the transport's
+// own. If that client uses a custom RoundTripper other than *http.Transport,
+// the timeouts cannot be applied and a warning is logged instead.
+func WithDialTimeouts(connectTimeout, tlsTimeout, responseHeaderTimeout time.Duration) ClientOption {
+	return func(c *clientConfig) {
+		c.dialTimeouts = &dialTimeouts{
+			connect:        connectTimeout,
+			tlsHandshake:   tlsTimeout,
+			responseHeader: responseHeaderTimeout,
+		}
+	}
+}
+
+// applyDialTimeouts replaces the configured HTTP client with a copy whose
+// transport uses the configured dial timeouts.
+func (c *clientConfig) applyDialTimeouts() {
+	transport, ok := c.cloneTransport()
+	if !ok {
+		if c.logger != nil {
+			c.logger.Warn("kuvera: cannot apply dial timeouts to a custom transport")
+		}
+		return
+	}
+
+	t := c.dialTimeouts
+	if t.connect > 0 {
+		dialer := &net.Dialer{
+			Timeout:   t.connect,
+			KeepAlive: 30 * time.Second,
+		}
+		transport.DialContext = dialer.DialContext
+	}
+	if t.tlsHandshake > 0 {
+		transport.TLSHandshakeTimeout = t.tlsHandshake
+	}
+	if t.responseHeader > 0 {
+		transport.ResponseHeaderTimeout = t.responseHeader
+	}
+
+	c.setTransport(transport)
+}
+
+// WithForceHTTP1 is a compatibility switch that disables HTTP/2, so that every
+// request uses HTTP/1.1. It is a workaround for proxies and other
+// intermediaries whose HTTP/2 support is flaky, which shows up as intermittent
+// stream errors. Leave it off unless such errors occur.
+//
+// Like WithDialTimeouts, it applies to the client set with WithHTTPClient
+// regardless of option order, copying the client and cloning its
+// *http.Transport rather than modifying them. If that client uses a custom
+// RoundTripper other than *http.Transport, HTTP/2 cannot be disabled and a
+// warning is logged instead.
+func WithForceHTTP1() ClientOption {
+	return func(c *clientConfig) {
+		c.forceHTTP1 = true
+	}
+}
+
+// applyForceHTTP1 replaces the configured HTTP client with a copy whose
+// transport does not negotiate HTTP/2.
+func (c *clientConfig) applyForceHTTP1() {
+	transport, ok := c.cloneTransport()
+	if !ok {
+		if c.logger != nil {
+			c.logger.Warn("kuvera: cannot disable HTTP/2 on a custom transport")
+		}
+		return
+	}
+
+	// A non-nil, empty TLSNextProto is the documented way to disable HTTP/2.
+	// A transport that has been used may already advertise h2 in its TLS
+	// config, which would let the server pick a protocol the client no longer
+	// speaks, so it is dropped from there as well.
+	transport.ForceAttemptHTTP2 = false
+	transport.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+	if transport.TLSClientConfig != nil {
+		transport.TLSClientConfig = transport.TLSClientConfig.Clone()
+		transport.TLSClientConfig.NextProtos = slices.DeleteFunc(slices.Clone(transport.TLSClientConfig.NextProtos), func(proto string) bool {
+			return proto == "h2"
+		})
+	}
+
+	c.setTransport(transport)
+}
+
+// cloneTransport returns a clone of the configured HTTP client's transport,
+// falling back to http.DefaultTransport. It reports false if the client uses a
+// RoundTripper that is not an *http.Transport.
+func (c *clientConfig) cloneTransport() (*http.Transport, bool) {
+	switch t := c.httpClient.Transport.(type) {
+	case nil:
+		return http.DefaultTransport.(*http.Transport).Clone(), true
+	case *http.Transport:
+		return t.Clone(), true
+	default:
+		return nil, false
+	}
+}
+
+// setTransport replaces the configured HTTP client with a copy using transport,
+// leaving any client passed to WithHTTPClient untouched.
+func (c *clientConfig) setTransport(transport *http.Transport) {
+	httpClient := *c.httpClient
+	httpClient.Transport = transport
+	c.httpClient = &httpClient
+}
@@ -0,0 +1,113 @@
+package kuvera
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWithDialTimeoutsConnect(t *testing.T) {
+	// 10.255.255.1 is not routable, so the connection attempt hangs until the
+	// connect timeout fires (or fails immediately without a route)
+	const connectTimeout = 200 * time.Millisecond
+	client := NewClient(
+		WithBaseURL("http://10.255.255.1:81"),
+		WithTimeout(30*time.Second),
+		WithDialTimeouts(connectTimeout, time.Second, time.Second),
+	).(*Client)
+	client.accessToken = "test-token"
+
+	start := time.Now()
+	err := client.Ping(context.Background())
+	elapsed := time.Since(start)
+
+	if err == nil {
+		t.Fatal("Ping() error = nil, want connect error")
+	}
+	if elapsed > connectTimeout+2*time.Second {
+		t.Errorf("Ping() took %v, want it to fail within the connect timeout", elapsed)
+	}
+}
+
+func TestWithDialTimeoutsTransport(t *testing.T) {
+	client := NewClient(WithDialTimeouts(time.Second, 2*time.Second, 3*time.Second)).(*Client)
+
+	transport, ok := client.httpClient.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("Transport = %T, want *http.Transport", client.httpClient.Transport)
+	}
+	if transport.TLSHandshakeTimeout != 2*time.Second {
+		t.Errorf("TLSHandshakeTimeout = %v, want 2s", transport.TLSHandshakeTimeout)
+	}
+	if transport.ResponseHeaderTimeout != 3*time.Second {
+		t.Errorf("ResponseHeaderTimeout = %v, want 3s", transport.ResponseHeaderTimeout)
+	}
+	if transport.DisableKeepAlives {
+		t.Error("DisableKeepAlives = true, want keep-alives enabled")
+	}
+	if client.httpClient.Timeout != DefaultTimeout {
+		t.Errorf("Timeout = %v, want %v", client.httpClient.Timeout, DefaultTimeout)
+	}
+}
+
+func TestWithDialTimeoutsCustomHTTPClient(t *testing.T) {
+	custom := &http.Client{Timeout: 5 * time.Second}
+	// Option order must not matter
+	client := NewClient(WithDialTimeouts(0, 2*time.Second, 0), WithHTTPClient(custom)).(*Client)
+
+	if custom.Transport != nil {
+		t.Error("WithDialTimeouts modified the provided client")
+	}
+	if client.httpClient.Timeout != 5*time.Second {
+		t.Errorf("Timeout = %v, want 5s", client.httpClient.Timeout)
+	}
+	transport, ok := client.httpClient.Transport.(*http.Transport)
+	if !ok || transport.TLSHandshakeTimeout != 2*time.Second {
+		t.Errorf("Transport = %#v, want TLS handshake timeout of 2s", client.httpClient.Transport)
+	}
+}
+
+func TestWithForceHTTP1Transport(t *testing.T) {
+	client := NewClient(WithForceHTTP1()).(*Client)
+
+	transport, ok := client.httpClient.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("Transport = %T, want *http.Transport", client.httpClient.Transport)
+	}
+	if transport.ForceAttemptHTTP2 || transport.TLSNextProto == nil || len(transport.TLSNextProto) != 0 {
+		t.Errorf("ForceAttemptHTTP2 = %t, TLSNextProto = %v, want HTTP/2 disabled", transport.ForceAttemptHTTP2, transport.TLSNextProto)
+	}
+}
+
+func TestWithForceHTTP1(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Proto", r.Proto)
+		w.Write([]byte(`{"status":"success"}`))
+	}))
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	for _, force := range []bool{false, true} {
+		options := []ClientOption{WithBaseURL(server.URL), WithInsecureSkipVerify()}
+		if force {
+			options = append(options, WithForceHTTP1())
+		}
+		client := NewClient(options...).(*Client)
+		client.accessToken = "test-token"
+
+		_, resp, err := client.GetGoldPriceWithResponse(context.Background())
+		if err != nil {
+			t.Fatalf("force=%t: GetGoldPriceWithResponse() error = %v", force, err)
+		}
+		want := "HTTP/2.0"
+		if force {
+			want = "HTTP/1.1"
+		}
+		if got := resp.Header.Get("X-Proto"); got != want {
+			t.Errorf("force=%t: request protocol = %s, want %s", force, got, want)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package kuvera
+
+import "sync/atomic"
+
+// WithUserAgentRotation sends a different User-Agent with each request, cycling
+// through agents in order. This can help long-running integrations that are
+// blocked for always presenting the same browser fingerprint.
+//
+// It takes precedence over WithUserAgent. If agents is empty, the single
+// User-Agent (DefaultUserAgent unless set with WithUserAgent) is used.
+func WithUserAgentRotation(agents []string) ClientOption {
+	return func(c *clientConfig) {
+		if len(agents) == 0 {
+			c.userAgents = nil
+			return
+		}
+		c.userAgents = &userAgentRotation{agents: append([]string(nil), agents...)}
+	}
+}
+
+// userAgentRotation hands out User-Agents round-robin. It is safe for
+// concurrent use.
+type userAgentRotation struct {
+	agents []string
+	next   atomic.Uint64
+}
+
+// pick returns the next User-Agent in the rotation.
+func (r *userAgentRotation) pick() string {
+	n := r.next.Add(1) - 1
+	return r.agents[n%uint64(len(r.agents))]
+}
+
+// requestUserAgent returns the User-Agent to send with the next request.
+func (c *Client) requestUserAgent() string {
+	if c.userAgents != nil {
+		return c.userAgents.pick()
+	}
+	return c.userAgent
+}
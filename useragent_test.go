@@ -0,0 +1,45 @@
+package kuvera
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestWithUserAgentRotation(t *testing.T) {
+	agents := []string{"agent-a", "agent-b", "agent-c"}
+
+	var got []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Get("User-Agent"))
+		w.Write([]byte(`{}`))
+	}, WithUserAgentRotation(agents))
+
+	for i := 0; i < 4; i++ {
+		if err := client.Ping(context.Background()); err != nil {
+			t.Fatalf("Ping() error = %v", err)
+		}
+	}
+
+	want := []string{"agent-a", "agent-b", "agent-c", "agent-a"}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("request %d User-Agent = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestWithUserAgentRotationEmpty(t *testing.T) {
+	var got string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("User-Agent")
+		w.Write([]byte(`{}`))
+	}, WithUserAgentRotation(nil))
+
+	if err := client.Ping(context.Background()); err != nil {
+		t.Fatalf("Ping() error = %v", err)
+	}
+	if got != DefaultUserAgent {
+		t.Errorf("User-Agent = %q, want %q", got, DefaultUserAgent)
+	}
+}
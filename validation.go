@@ -0,0 +1,56 @@
+package kuvera
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrSuspiciousResponse is returned by clients constructed with
+// WithResponseValidation when a successful response looks like a backend glitch.
+var ErrSuspiciousResponse = errors.New("suspicious API response")
+
+// WithResponseValidation enables sanity checks on decoded responses.
+//
+// Kuvera occasionally answers with a 200 carrying an empty portfolio during
+// backend hiccups. With validation enabled such responses fail with
+// ErrSuspiciousResponse so callers can retry instead of persisting bad data.
+// Validation is opt-in so that legitimately empty data never breaks by default.
+func WithResponseValidation() ClientOption {
+	return func(c *clientConfig) {
+		c.validateResponses = true
+	}
+}
+
+// responseValidator is implemented by response types that can check a decoded
+// response for signs of a backend glitch. The raw body is passed alongside
+// since structural problems are not visible on the decoded struct.
+type responseValidator interface {
+	validate(body []byte) error
+}
+
+// validate flags portfolio responses that are not successful, or that claim
+// success with a structurally empty data object.
+//
+// A brand-new account still receives the full structure (asset class objects
+// with zero values) and passes. A glitch typically yields a missing, null, or
+// empty data object, or one without any asset class objects, which is flagged.
+func (r *PortfolioResponse) validate(body []byte) error {
+	if r.Status != "success" {
+		return fmt.Errorf("%w: portfolio status %q", ErrSuspiciousResponse, r.Status)
+	}
+
+	var raw struct {
+		Data map[string]json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return fmt.Errorf("%w: %v", ErrSuspiciousResponse, err)
+	}
+
+	for _, key := range []string{"mutual_funds", "gold", "indian_equities", "fixed_deposit"} {
+		if _, ok := raw.Data[key]; ok {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: portfolio data is structurally empty", ErrSuspiciousResponse)
+}
@@ -0,0 +1,57 @@
+package kuvera
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestWithResponseValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		wantErr bool
+	}{
+		{
+			name: "new account",
+			body: `{"status":"success","data":{"current_value":0,"invested":0,"us_equities":{},"epf":{},
+				"gold":{"current_value":0,"kuvera":{},"imported":{}},"indian_equities":{"current_value":0},
+				"mutual_funds":{"current_value":0},"save_smarts":{},"fixed_deposit":{"current_value":0,"fd_details":[]}}}`,
+		},
+		{
+			name: "populated",
+			body: `{"status":"success","data":{"current_value":1000,"mutual_funds":{"current_value":1000}}}`,
+		},
+		{name: "success but empty data", body: `{"status":"success","data":{}}`, wantErr: true},
+		{name: "success but zero totals only", body: `{"status":"success","data":{"current_value":0,"invested":0}}`, wantErr: true},
+		{name: "success without data", body: `{"status":"success"}`, wantErr: true},
+		{name: "not success", body: `{"status":"error","data":{"mutual_funds":{}}}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(tt.body))
+			}, WithResponseValidation())
+
+			_, err := client.GetPortfolio(context.Background())
+			if tt.wantErr && !errors.Is(err, ErrSuspiciousResponse) {
+				t.Errorf("GetPortfolio() error = %v, want %v", err, ErrSuspiciousResponse)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("GetPortfolio() error = %v, want nil", err)
+			}
+		})
+	}
+}
+
+func TestResponseValidationDisabledByDefault(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":"success","data":{}}`))
+	})
+
+	if _, err := client.GetPortfolio(context.Background()); err != nil {
+		t.Errorf("GetPortfolio() error = %v, want nil", err)
+	}
+}
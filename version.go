@@ -0,0 +1,147 @@
+package kuvera
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+)
+
+// DefaultAPIVersion is the Kuvera web app version sent with login and gold
+// price requests unless overridden with WithAPIVersion.
+const DefaultAPIVersion = "1.239.2"
+
+// WebAppURL is the Kuvera web app that DiscoverAPIVersion inspects.
+const WebAppURL = "https://kuvera.in"
+
+// ErrAPIVersionNotFound is returned by DiscoverAPIVersion when the web app no
+// longer contains a recognisable version string.
+var ErrAPIVersionNotFound = errors.New("API version not found in web app")
+
+// maxVersionScripts is how many of the web app's scripts DiscoverAPIVersion
+// searches before giving up.
+const maxVersionScripts = 5
+
+var (
+	// appVersionPattern matches an app-specific version assignment such as
+	// appVersion:'1.239.2' in HTML or minified JavaScript
+	appVersionPattern = regexp.MustCompile(`\bapp_?[Vv]ersion["']?\s*[:=]\s*["'](\d+\.\d+\.\d+)["']`)
+	// versionPattern matches a plain version:"1.239.2" assignment, but only
+	// inside an object literal that also mentions Kuvera, such as the app's
+	// environment config. Vendor bundles like React or core-js carry their own
+	// versions, which must not be mistaken for the app's.
+	versionPattern = regexp.MustCompile(`\{[^{}]*?(?:(?i:kuvera)[^{}]*?\b[Vv]ersion["']?\s*[:=]\s*["'](\d+\.\d+\.\d+)["']|\b[Vv]ersion["']?\s*[:=]\s*["'](\d+\.\d+\.\d+)["'][^{}]*?(?i:kuvera))`)
+	// scriptPattern matches the source of a script tag
+	scriptPattern = regexp.MustCompile(`<script[^>]+src=["']([^"']+\.js)["']`)
+)
+
+// WithAPIVersion sets the Kuvera web app version the client reports to the
+// API, in place of DefaultAPIVersion. Kuvera may reject logins from versions
+// it considers outdated; DiscoverAPIVersion finds the current one. An empty
+// version is ignored.
+func WithAPIVersion(version string) ClientOption {
+	return func(c *clientConfig) {
+		if version != "" {
+			c.apiVersion = version
+		}
+	}
+}
+
+// DiscoverAPIVersion fetches the Kuvera web app and extracts the client version
+// it currently reports, for use with WithAPIVersion. The version is looked for
+// in the page itself and then in the scripts it loads from the same site.
+//
+// If the page format has changed so that no version can be found, it returns
+// ErrAPIVersionNotFound rather than guessing. The result only changes when
+// Kuvera releases a new web app, so it is worth caching.
+//
+// Example:
+//
+//	version, err := kuvera.DiscoverAPIVersion(ctx)
+//	if err != nil {
+//		log.Printf("using default API version: %v", err)
+//		version = kuvera.DefaultAPIVersion
+//	}
+//	client := kuvera.NewClient(kuvera.WithAPIVersion(version))
+func DiscoverAPIVersion(ctx context.Context) (string, error) {
+	return discoverAPIVersion(contextOrBackground(ctx), &http.Client{Timeout: DefaultTimeout}, WebAppURL)
+}
+
+// discoverAPIVersion implements DiscoverAPIVersion against the web app at pageURL.
+func discoverAPIVersion(ctx context.Context, client *http.Client, pageURL string) (string, error) {
+	base, err := url.Parse(pageURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid web app URL: %w", err)
+	}
+
+	page, err := fetchWebAsset(ctx, client, base.String())
+	if err != nil {
+		return "", err
+	}
+	if version, ok := findAPIVersion(page); ok {
+		return version, nil
+	}
+
+	scripts := scriptPattern.FindAllSubmatch(page, -1)
+	for i, m := range scripts {
+		if i == maxVersionScripts {
+			break
+		}
+		src, err := base.Parse(string(m[1]))
+		if err != nil || src.Host != base.Host {
+			continue
+		}
+		script, err := fetchWebAsset(ctx, client, src.String())
+		if err != nil {
+			return "", err
+		}
+		if version, ok := findAPIVersion(script); ok {
+			return version, nil
+		}
+	}
+	return "", ErrAPIVersionNotFound
+}
+
+// findAPIVersion extracts the Kuvera app version from a page or script.
+func findAPIVersion(b []byte) (string, bool) {
+	if m := appVersionPattern.FindSubmatch(b); m != nil {
+		return string(m[1]), true
+	}
+	if m := versionPattern.FindSubmatch(b); m != nil {
+		if len(m[1]) > 0 {
+			return string(m[1]), true
+		}
+		return string(m[2]), true
+	}
+	return "", false
+}
+
+// fetchWebAsset downloads a page or script of the web app.
+func fetchWebAsset(ctx context.Context, client *http.Client, assetURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", assetURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("User-Agent", DefaultUserAgent)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("web app request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("web app request for %s failed with status code: %d", assetURL, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, DefaultMaxResponseBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read web app response: %w", err)
+	}
+	if len(body) > DefaultMaxResponseBytes {
+		return nil, ErrResponseTooLarge
+	}
+	return body, nil
+}
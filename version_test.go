@@ -0,0 +1,80 @@
+package kuvera
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDiscoverAPIVersion(t *testing.T) {
+	pages := map[string]string{
+		"/":                 `<!doctype html><html><head><script src="/runtime.1a2b.js"></script><script src="/main.3c4d.js" defer></script></head></html>`,
+		"/runtime.1a2b.js":  `(()=>{"use strict";var e={};})();`,
+		"/main.3c4d.js":     `var n={production:!0,apiUrl:"https://api.kuvera.in",version:"1.241.0"};`,
+		"/inline/":          `<html><script>window.APP_CONFIG={appVersion:'1.240.3'}</script></html>`,
+		"/changed/":         `<html><script src="/changed/app.js"></script></html>`,
+		"/changed/app.js":   `var n={version:"latest"};`,
+		"/external/":        `<html><script src="https://cdn.example.com/main.js"></script></html>`,
+		"/external/main.js": `var n={version:"9.9.9"};`,
+		"/missing-script/":  `<html><script src="/gone.js"></script></html>`,
+		"/no-scripts/":      `<html><body>Maintenance</body></html>`,
+		"/vendor/":          `<html><script src="/vendor/vendor.js"></script><script src="/vendor/main.js"></script></html>`,
+		"/vendor/vendor.js": `exports.version="18.2.0";(t.exports=function(t,e){return o[t]||(o[t]=e)})("versions",[]).push({version:"3.30.2",mode:"global",copyright:"© 2014-2023 Denis Pushkarev (zloirock.ru)"});`,
+		"/vendor/main.js":   `var n={production:!0,apiUrl:"https://api.kuvera.in"};`,
+		"/reversed/":        `<html><script>var e={version:"1.242.1",apiUrl:"https://api.kuvera.in"}</script></html>`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, ok := pages[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(page))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		path    string
+		want    string
+		wantErr error
+	}{
+		{"/", "1.241.0", nil},
+		{"/inline/", "1.240.3", nil},
+		{"/changed/", "", ErrAPIVersionNotFound},
+		{"/external/", "", ErrAPIVersionNotFound},
+		{"/no-scripts/", "", ErrAPIVersionNotFound},
+		{"/vendor/", "", ErrAPIVersionNotFound},
+		{"/reversed/", "1.242.1", nil},
+	}
+	for _, tt := range tests {
+		got, err := discoverAPIVersion(context.Background(), server.Client(), server.URL+tt.path)
+		if got != tt.want || !errors.Is(err, tt.wantErr) {
+			t.Errorf("discoverAPIVersion(%s) = %q, %v, want %q, %v", tt.path, got, err, tt.want, tt.wantErr)
+		}
+	}
+
+	if _, err := discoverAPIVersion(context.Background(), server.Client(), server.URL+"/missing-script/"); err == nil {
+		t.Error("discoverAPIVersion() with a missing script succeeded, want an error")
+	}
+}
+
+func TestWithAPIVersion(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var req LoginRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decode login request: %v", err)
+			return
+		}
+		if req.V != "1.241.0" {
+			t.Errorf("login version = %q, want 1.241.0", req.V)
+		}
+		w.Write([]byte(`{"status":"success","token":"jwt"}`))
+	}, WithAPIVersion("1.241.0"))
+
+	if _, err := client.Login(context.Background(), "user", "pass"); err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+}
@@ -0,0 +1,118 @@
+package kuvera
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// ErrNotOnWatchlist is returned when removing a fund that is not on the watchlist.
+var ErrNotOnWatchlist = errors.New("fund is not on the watchlist")
+
+// WatchlistFund represents a fund on the user's watchlist.
+type WatchlistFund struct {
+	// Code is the Kuvera fund code
+	Code string `json:"code"`
+	// Name is the fund name
+	Name string `json:"name"`
+	// NAV is the current Net Asset Value
+	NAV float64 `json:"nav"`
+	// OneDayChange is the one-day change in NAV
+	OneDayChange float64 `json:"one_day_change"`
+}
+
+// WatchlistResponse represents the response from the watchlist API endpoint.
+type WatchlistResponse struct {
+	// Status indicates if the request was successful
+	Status string `json:"status"`
+	// Data contains the watched funds
+	Data []WatchlistFund `json:"data"`
+}
+
+// watchlistRequest is the request payload for adding a fund to the watchlist.
+type watchlistRequest struct {
+	FundCode string `json:"fund_code"`
+}
+
+// GetWatchlist retrieves the funds on the user's watchlist.
+//
+// The user must be authenticated (logged in) before calling this method.
+//
+// Returns:
+//   - WatchlistResponse: Contains the watched funds with current NAV
+//   - error: Authentication errors, network errors, or API errors
+//
+// Example:
+//
+//	watchlist, err := client.GetWatchlist(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, fund := range watchlist.Data {
+//		fmt.Printf("%s: NAV ₹%.4f (%+.2f)\n", fund.Name, fund.NAV, fund.OneDayChange)
+//	}
+func (c *Client) GetWatchlist(ctx context.Context) (*WatchlistResponse, error) {
+	if c.token() == "" {
+		return nil, ErrNotAuthenticated
+	}
+
+	resp, err := c.makeRequest(ctx, "GET", "/api/v3/watchlist.json", nil)
+	if err != nil {
+		return nil, fmt.Errorf("watchlist request failed: %w", err)
+	}
+
+	var watchlistResp WatchlistResponse
+	if err := c.handleResponse(resp, &watchlistResp, "watchlist"); err != nil {
+		return &watchlistResp, err
+	}
+
+	return &watchlistResp, nil
+}
+
+// AddToWatchlist adds a fund to the user's watchlist.
+//
+// The user must be authenticated (logged in) before calling this method.
+func (c *Client) AddToWatchlist(ctx context.Context, fundCode string) error {
+	if err := validateFundCode(fundCode); err != nil {
+		return err
+	}
+	if c.token() == "" {
+		return ErrNotAuthenticated
+	}
+
+	resp, err := c.makeRequest(ctx, "POST", "/api/v3/watchlist.json", watchlistRequest{FundCode: fundCode})
+	if err != nil {
+		return fmt.Errorf("add to watchlist request failed: %w", err)
+	}
+
+	return c.handleResponse(resp, nil, "add to watchlist")
+}
+
+// RemoveFromWatchlist removes a fund from the user's watchlist.
+//
+// Removing a fund that is not on the watchlist returns ErrNotOnWatchlist rather
+// than succeeding silently. The user must be authenticated (logged in) before
+// calling this method.
+func (c *Client) RemoveFromWatchlist(ctx context.Context, fundCode string) error {
+	if err := validateFundCode(fundCode); err != nil {
+		return err
+	}
+	if c.token() == "" {
+		return ErrNotAuthenticated
+	}
+
+	endpoint := "/api/v3/watchlist/" + url.PathEscape(fundCode) + ".json"
+	resp, err := c.makeRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("remove from watchlist request failed: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return fmt.Errorf("%w: %s", ErrNotOnWatchlist, fundCode)
+	}
+
+	return c.handleResponse(resp, nil, "remove from watchlist")
+}
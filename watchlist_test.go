@@ -0,0 +1,134 @@
+package kuvera
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeWatchlist is an in-memory watchlist server for tests.
+type fakeWatchlist struct {
+	mu    sync.Mutex
+	funds map[string]WatchlistFund
+}
+
+func (f *fakeWatchlist) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch {
+	case r.Method == "GET" && r.URL.Path == "/api/v3/watchlist.json":
+		resp := WatchlistResponse{Status: "success", Data: []WatchlistFund{}}
+		for _, fund := range f.funds {
+			resp.Data = append(resp.Data, fund)
+		}
+		json.NewEncoder(w).Encode(resp)
+	case r.Method == "POST" && r.URL.Path == "/api/v3/watchlist.json":
+		var req watchlistRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		f.funds[req.FundCode] = WatchlistFund{Code: req.FundCode, Name: "Fund " + req.FundCode, NAV: 42.5, OneDayChange: 0.3}
+		w.Write([]byte(`{"status":"success"}`))
+	case r.Method == "DELETE" && strings.HasPrefix(r.URL.Path, "/api/v3/watchlist/"):
+		code := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/v3/watchlist/"), ".json")
+		if _, ok := f.funds[code]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code":404,"message":"not found"}`))
+			return
+		}
+		delete(f.funds, code)
+		w.Write([]byte(`{"status":"success"}`))
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestWatchlistFlow(t *testing.T) {
+	server := &fakeWatchlist{funds: map[string]WatchlistFund{}}
+	client := newTestClient(t, server.ServeHTTP)
+	ctx := context.Background()
+
+	if err := client.AddToWatchlist(ctx, "PPFAS-GR"); err != nil {
+		t.Fatalf("AddToWatchlist() error = %v", err)
+	}
+
+	watchlist, err := client.GetWatchlist(ctx)
+	if err != nil {
+		t.Fatalf("GetWatchlist() error = %v", err)
+	}
+	if len(watchlist.Data) != 1 || watchlist.Data[0].Code != "PPFAS-GR" || watchlist.Data[0].NAV != 42.5 {
+		t.Fatalf("unexpected watchlist: %+v", watchlist.Data)
+	}
+
+	if err := client.RemoveFromWatchlist(ctx, "PPFAS-GR"); err != nil {
+		t.Fatalf("RemoveFromWatchlist() error = %v", err)
+	}
+
+	watchlist, err = client.GetWatchlist(ctx)
+	if err != nil {
+		t.Fatalf("GetWatchlist() error = %v", err)
+	}
+	if len(watchlist.Data) != 0 {
+		t.Errorf("watchlist after removal = %+v, want empty", watchlist.Data)
+	}
+}
+
+func TestWatchlistWithCache(t *testing.T) {
+	server := &fakeWatchlist{funds: map[string]WatchlistFund{}}
+	client := newTestClient(t, server.ServeHTTP, WithCache(time.Hour))
+	ctx := context.Background()
+
+	// Prime the cache with the empty watchlist
+	if _, err := client.GetWatchlist(ctx); err != nil {
+		t.Fatalf("GetWatchlist() error = %v", err)
+	}
+
+	if err := client.AddToWatchlist(ctx, "PPFAS-GR"); err != nil {
+		t.Fatalf("AddToWatchlist() error = %v", err)
+	}
+	watchlist, err := client.GetWatchlist(ctx)
+	if err != nil {
+		t.Fatalf("GetWatchlist() error = %v", err)
+	}
+	if len(watchlist.Data) != 1 {
+		t.Fatalf("watchlist after add = %+v, want one fund", watchlist.Data)
+	}
+
+	if err := client.RemoveFromWatchlist(ctx, "PPFAS-GR"); err != nil {
+		t.Fatalf("RemoveFromWatchlist() error = %v", err)
+	}
+	watchlist, err = client.GetWatchlist(ctx)
+	if err != nil {
+		t.Fatalf("GetWatchlist() error = %v", err)
+	}
+	if len(watchlist.Data) != 0 {
+		t.Errorf("watchlist after removal = %+v, want empty", watchlist.Data)
+	}
+}
+
+func TestRemoveFromWatchlistNotFound(t *testing.T) {
+	server := &fakeWatchlist{funds: map[string]WatchlistFund{}}
+	client := newTestClient(t, server.ServeHTTP)
+
+	err := client.RemoveFromWatchlist(context.Background(), "MISSING")
+	if !errors.Is(err, ErrNotOnWatchlist) {
+		t.Errorf("RemoveFromWatchlist() error = %v, want %v", err, ErrNotOnWatchlist)
+	}
+}
+
+func TestWatchlistEmptyFundCode(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request expected for an empty fund code")
+	})
+
+	if err := client.AddToWatchlist(context.Background(), " "); !errors.Is(err, ErrEmptyFundCode) {
+		t.Errorf("AddToWatchlist() error = %v, want %v", err, ErrEmptyFundCode)
+	}
+	if err := client.RemoveFromWatchlist(context.Background(), ""); !errors.Is(err, ErrEmptyFundCode) {
+		t.Errorf("RemoveFromWatchlist() error = %v, want %v", err, ErrEmptyFundCode)
+	}
+}